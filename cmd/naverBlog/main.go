@@ -1,19 +1,44 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
+	"naverCrawler/internal/chunk"
 	"naverCrawler/internal/crawling"
+	"naverCrawler/internal/dedup"
+	"naverCrawler/internal/redact"
+	"naverCrawler/internal/textproc"
 	"os"
 
 	"github.com/joho/godotenv"
 )
 
+var (
+	recordDir     = flag.String("record", "", "네이버 응답 원본을 저장할 카세트 디렉토리")
+	replayDir     = flag.String("replay", "", "네트워크 대신 응답을 재생할 카세트 디렉토리")
+	concurrency   = flag.Int("concurrency", 4, "동시에 진행할 목록/상세 요청 수")
+	maxPages      = flag.Int("max-pages", 10, "크롤링할 최대 목록 페이지 수 (0은 블로그 전체)")
+	pageSize      = flag.Int("page-size", 30, "목록 페이지당 게시글 수 (5, 10, 15, 20, 30)")
+	dedupIndex    = flag.String("dedup-index", "", "중복 인덱스 파일 (이전 실행에서 수집한 게시글 제외, 유사 중복 표시)")
+	backendName   = flag.String("backend", "desktop", "블로그 백엔드 (desktop: PC 웹 HTML, mobile: 목록은 모바일 JSON API, 본문은 모바일 PostView HTML)")
+	redactPII     = flag.Bool("redact", false, "개인정보 가리기 (NAVER_REDACT_SALT가 있으면 작성자도 가명으로)")
+	textFeatures  = flag.Bool("text-features", false, "본문 문장/토큰/이모지를 text_features로 저장")
+	stripEmoji    = flag.Bool("strip-emoji", false, "분석용 본문에서 이모지/이모티콘 지우기")
+	chunks        = flag.Bool("chunks", false, "임베딩용 청크를 JSONL로 함께 저장")
+	chunkChars    = flag.Int("chunk-chars", 1000, "청크당 최대 글자 수 (0은 제한 없음)")
+	chunkTokens   = flag.Int("chunk-tokens", 0, "청크당 최대 토큰 수 (0은 제한 없음)")
+	chunkOverlap  = flag.Int("chunk-overlap", 150, "앞 청크와 겹칠 최대 글자 수")
+	chunkComments = flag.Bool("chunk-comments", false, "댓글도 청크로 저장")
+)
+
 func init() {
 	log.SetFlags(log.LstdFlags | log.Lmicroseconds)
 }
 
 func main() {
+	flag.Parse()
+
 	err := godotenv.Load()
 	if err != nil && !os.IsNotExist(err) {
 		fmt.Println("Error loading .env file:", err)
@@ -25,15 +50,72 @@ func main() {
 		log.Fatal("NAVER_BLOG_ID 환경 변수가 설정되지 않았습니다.")
 	}
 
-	maxPages := 10
+	httpConfig, err := crawling.HTTPConfigFromEnv()
+	if err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if err := crawling.ConfigureHTTP(httpConfig); err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if err := crawling.UseCassette(*recordDir, *replayDir); err != nil {
+		log.Fatal("❌ ", err)
+	}
+
+	backend, err := crawling.BlogBackendByName(*backendName)
+	if err != nil {
+		log.Fatal("❌ ", err)
+	}
 
 	log.Printf("🎯 대상 블로그: %s", blogID)
-	log.Printf("📄 크롤링 페이지 수: %d", maxPages)
+	if *maxPages > 0 {
+		log.Printf("📄 크롤링 페이지 수: %d", *maxPages)
+	} else {
+		log.Printf("📄 크롤링 페이지 수: 전체")
+	}
 
-	posts, err := crawling.CrawlBlog(blogID, maxPages)
+	redactor := redact.NewIf(*redactPII, os.Getenv("NAVER_REDACT_SALT"))
+	if redactor != nil {
+		log.Printf("🔒 개인정보 가리기: %s", redactor.Mode())
+	}
+	textOptions := textproc.FeatureOptions(*textFeatures, *stripEmoji)
+	chunkOptions := chunk.OptionsIf(*chunks, chunk.Options{
+		MaxChars:        *chunkChars,
+		MaxTokens:       *chunkTokens,
+		Overlap:         *chunkOverlap,
+		IncludeComments: *chunkComments,
+	})
+
+	var index *dedup.Index
+	if *dedupIndex != "" {
+		if index, err = dedup.Load(*dedupIndex); err != nil {
+			log.Fatal("❌ ", err)
+		}
+		log.Printf("♻️ 중복 인덱스: %s (%d개 게시글)", *dedupIndex, index.Len())
+	}
+
+	posts, err := crawling.CrawlBlog(blogID, crawling.BlogCrawlOptions{
+		MaxPages:    *maxPages,
+		PageSize:    *pageSize,
+		Concurrency: *concurrency,
+		Backend:     backend,
+		Profile:     true,
+		Dedup:       index,
+		Redact:      redactor,
+		Text:        textOptions,
+		Chunks:      chunkOptions,
+	})
 	if err != nil {
 		log.Fatal("❌ 크롤링 중 오류 발생:", err)
 	}
 
+	if index != nil {
+		if err := index.Save(); err != nil {
+			log.Fatal("❌ ", err)
+		}
+	}
+
+	if redactor != nil {
+		log.Printf("🔒 가린 개인정보: %s", redactor.Summary())
+	}
 	fmt.Printf("✅ 크롤링 완료! 총 %d개 블로그 게시글 수집\n", len(posts))
 }
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"naverCrawler/internal/crawling"
+	"os"
+	"strings"
+
+	"github.com/joho/godotenv"
+)
+
+var (
+	recordDir     = flag.String("record", "", "네이버 응답 원본을 저장할 카세트 디렉토리")
+	replayDir     = flag.String("replay", "", "네트워크 대신 응답을 재생할 카세트 디렉토리")
+	depth         = flag.Int("depth", 1, "시작 블로그에서 따라갈 최대 거리")
+	maxBlogs      = flag.Int("max-blogs", 50, "방문할 최대 블로그 수")
+	neighborPages = flag.Int("neighbor-pages", 5, "블로그마다 확인할 이웃 목록 페이지 수")
+	commenters    = flag.Bool("commenters", false, "댓글 작성자의 블로그도 따라가기")
+	crawlPosts    = flag.Bool("crawl-posts", false, "방문한 블로그의 게시글도 수집 (그래프와 함께 graph_*_posts.json으로 저장)")
+	maxPages      = flag.Int("max-pages", 1, "게시글을 수집할 때 블로그마다 크롤링할 목록 페이지 수 (0은 블로그 전체)")
+)
+
+func init() {
+	log.SetFlags(log.LstdFlags | log.Lmicroseconds)
+}
+
+// 시작 블로그(인자 또는 NAVER_BLOG_ID, 쉼표로 여러 개)에서 이웃/댓글 관계를 따라가며 그래프 수집
+func main() {
+	flag.Parse()
+
+	err := godotenv.Load()
+	if err != nil && !os.IsNotExist(err) {
+		fmt.Println("Error loading .env file:", err)
+		return
+	}
+
+	seeds := flag.Args()
+	if len(seeds) == 0 && os.Getenv("NAVER_BLOG_ID") != "" {
+		seeds = strings.Split(os.Getenv("NAVER_BLOG_ID"), ",")
+	}
+	if len(seeds) == 0 {
+		log.Fatal("NAVER_BLOG_ID 환경 변수를 설정하거나 시작 블로그 ID를 인자로 주세요.")
+	}
+
+	httpConfig, err := crawling.HTTPConfigFromEnv()
+	if err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if err := crawling.ConfigureHTTP(httpConfig); err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if err := crawling.UseCassette(*recordDir, *replayDir); err != nil {
+		log.Fatal("❌ ", err)
+	}
+
+	graph, err := crawling.CrawlNeighborGraph(seeds, crawling.NeighborGraphOptions{
+		Depth:            *depth,
+		MaxBlogs:         *maxBlogs,
+		MaxNeighborPages: *neighborPages,
+		FollowCommenters: *commenters,
+		CrawlPosts:       *crawlPosts,
+		PostOptions:      crawling.BlogCrawlOptions{MaxPages: *maxPages},
+	})
+	if err != nil {
+		log.Fatal("❌ 그래프 수집 중 오류 발생:", err)
+	}
+
+	if _, err := crawling.SaveNeighborGraph(graph, "output_blog"); err != nil {
+		log.Fatal("❌ ", err)
+	}
+	fmt.Printf("✅ 그래프 수집 완료! 블로그 %d개, 관계 %d개\n", len(graph.Nodes), len(graph.Edges))
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"naverCrawler/internal/crawling"
+	"os"
+
+	"github.com/joho/godotenv"
+)
+
+var (
+	recordDir = flag.String("record", "", "네이버 응답 원본을 저장할 카세트 디렉토리")
+	replayDir = flag.String("replay", "", "네트워크 대신 응답을 재생할 카세트 디렉토리")
+)
+
+func init() {
+	log.SetFlags(log.LstdFlags | log.Lmicroseconds)
+}
+
+// 블로그 게시글 없이 블로그 정보만 수집 (인자로 여러 블로그 ID를 주면 차례로 수집)
+func main() {
+	flag.Parse()
+
+	err := godotenv.Load()
+	if err != nil && !os.IsNotExist(err) {
+		fmt.Println("Error loading .env file:", err)
+		return
+	}
+
+	blogIDs := flag.Args()
+	if len(blogIDs) == 0 {
+		if blogID := os.Getenv("NAVER_BLOG_ID"); blogID != "" {
+			blogIDs = []string{blogID}
+		}
+	}
+	if len(blogIDs) == 0 {
+		log.Fatal("NAVER_BLOG_ID 환경 변수를 설정하거나 블로그 ID를 인자로 주세요.")
+	}
+
+	httpConfig, err := crawling.HTTPConfigFromEnv()
+	if err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if err := crawling.ConfigureHTTP(httpConfig); err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if err := crawling.UseCassette(*recordDir, *replayDir); err != nil {
+		log.Fatal("❌ ", err)
+	}
+
+	failed := 0
+	for _, blogID := range blogIDs {
+		log.Printf("🎯 대상 블로그: %s", blogID)
+		profile, err := crawling.GetBlogProfile(blogID)
+		if err != nil {
+			log.Printf("❌ 블로그 정보 가져오기 실패 (%s): %v", blogID, err)
+			failed++
+			continue
+		}
+		if _, err := crawling.SaveBlogProfile(profile, "output_blog"); err != nil {
+			log.Printf("❌ %v", err)
+			failed++
+			continue
+		}
+
+		fmt.Printf("📘 %s (%s) | 👥 이웃 %d명 | 📝 게시글 %d개 | 📅 %s ~ %s\n",
+			profile.Title, profile.NickName, profile.NeighborCount, profile.TotalPostCount,
+			profile.FirstPostDate, profile.LastPostDate)
+	}
+
+	if failed > 0 {
+		log.Fatalf("❌ %d개 블로그 정보 수집 실패", failed)
+	}
+}
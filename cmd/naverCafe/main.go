@@ -2,16 +2,42 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"os"
 	"strings"
+	"time"
 
+	"naverCrawler/internal/chunk"
 	"naverCrawler/internal/crawling"
+	"naverCrawler/internal/dedup"
+	"naverCrawler/internal/redact"
+	"naverCrawler/internal/textproc"
 
 	"github.com/joho/godotenv"
 )
 
+var (
+	recordDir     = flag.String("record", "", "네이버 응답 원본을 저장할 카세트 디렉토리")
+	replayDir     = flag.String("replay", "", "네트워크 대신 응답을 재생할 카세트 디렉토리")
+	listWorkers   = flag.Int("list-workers", 3, "동시에 목록 페이지를 가져올 작업자 수")
+	detailWorkers = flag.Int("detail-workers", 3, "동시에 게시글 상세 정보를 가져올 작업자 수")
+	startPage     = flag.Int("start-page", 1, "크롤링을 시작할 게시판 페이지")
+	endPage       = flag.Int("end-page", 0, "크롤링할 마지막 게시판 페이지 (0은 끝까지)")
+	countPages    = flag.Bool("count-pages", false, "게시판의 전체 페이지 수만 출력하고 종료 (-start-page, -end-page 범위를 정할 때 사용)")
+	dedupIndex    = flag.String("dedup-index", "", "중복 인덱스 파일 (게시판/검색 모드에서 이전 실행에서 수집한 게시글 제외, 유사 중복 표시)")
+	redactPII     = flag.Bool("redact", false, "개인정보 가리기 (NAVER_REDACT_SALT가 있으면 작성자도 가명으로)")
+	textFeatures  = flag.Bool("text-features", false, "본문 문장/토큰/이모지를 text_features로 저장")
+	stripEmoji    = flag.Bool("strip-emoji", false, "분석용 본문에서 이모지/이모티콘 지우기")
+	chunks        = flag.Bool("chunks", false, "임베딩용 청크를 JSONL로 함께 저장")
+	chunkChars    = flag.Int("chunk-chars", 1000, "청크당 최대 글자 수 (0은 제한 없음)")
+	chunkTokens   = flag.Int("chunk-tokens", 0, "청크당 최대 토큰 수 (0은 제한 없음)")
+	chunkOverlap  = flag.Int("chunk-overlap", 150, "앞 청크와 겹칠 최대 글자 수")
+	chunkComments = flag.Bool("chunk-comments", false, "댓글도 청크로 저장")
+)
+
 func saveToJSON(data interface{}, filename string) error {
 	jsonData, err := json.MarshalIndent(data, "", "  ")
 	if err != nil {
@@ -25,7 +51,46 @@ func saveToJSON(data interface{}, filename string) error {
 	return nil
 }
 
+// 환경 변수에서 검색 조건 읽기
+func searchOptionsFromEnv(query string) (crawling.CafeSearchOptions, error) {
+	opts := crawling.CafeSearchOptions{Query: query}
+
+	switch os.Getenv("NAVER_SEARCH_BY") {
+	case "", "all":
+		opts.SearchBy = crawling.SearchByAll
+	case "title":
+		opts.SearchBy = crawling.SearchByTitle
+	case "writer":
+		opts.SearchBy = crawling.SearchByWriter
+	case "comment":
+		opts.SearchBy = crawling.SearchByComment
+	default:
+		return opts, fmt.Errorf("알 수 없는 NAVER_SEARCH_BY 값: %s", os.Getenv("NAVER_SEARCH_BY"))
+	}
+
+	// 기간 (YYYY-MM-DD)
+	if v := os.Getenv("NAVER_SEARCH_START"); v != "" {
+		t, err := time.ParseInLocation("2006-01-02", v, time.Local)
+		if err != nil {
+			return opts, fmt.Errorf("NAVER_SEARCH_START 형식 오류: %v", err)
+		}
+		opts.StartDate = t
+	}
+	if v := os.Getenv("NAVER_SEARCH_END"); v != "" {
+		t, err := time.ParseInLocation("2006-01-02", v, time.Local)
+		if err != nil {
+			return opts, fmt.Errorf("NAVER_SEARCH_END 형식 오류: %v", err)
+		}
+		// 종료일 당일 작성 글까지 포함
+		opts.EndDate = t.Add(24*time.Hour - time.Second)
+	}
+
+	return opts, nil
+}
+
 func main() {
+	flag.Parse()
+
 	err := godotenv.Load()
 	if err != nil && !os.IsNotExist(err) {
 		fmt.Println("Error loading .env file:", err)
@@ -33,9 +98,33 @@ func main() {
 	}
 
 	cafeId := os.Getenv("NAVER_CAFE_ID") // 네이버 카페 ID 입력
-	cookie := os.Getenv("NAVER_COOKIE")  // 환경 변수에서 쿠키 가져오기
-	if cookie == "" {
-		log.Fatal("NAVER_COOKIE 환경 변수가 설정되지 않았습니다.")
+	httpConfig, err := crawling.HTTPConfigFromEnv()
+	if err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if err := crawling.ConfigureHTTP(httpConfig); err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if err := crawling.UseCassette(*recordDir, *replayDir); err != nil {
+		log.Fatal("❌ ", err)
+	}
+
+	session, err := crawling.SessionFromEnv()
+	if *replayDir != "" {
+		// 재생 모드에서는 녹화된 응답을 쓰므로 쿠키가 없어도 되고 만료 여부도 확인하지 않음
+		// 쿠키 설정이 아예 없을 때만 빈 세션을 쓰고, 쿠키 파일을 읽지 못하는 등 다른 오류는 그대로 알림
+		if errors.Is(err, crawling.ErrNoSessionEnv) {
+			session = crawling.NewSession("")
+		} else if err != nil {
+			log.Fatal("❌ ", err)
+		}
+	} else {
+		if err != nil {
+			log.Fatal("❌ ", err)
+		}
+		if err := session.Verify(); err != nil {
+			log.Fatal("❌ 세션 확인 실패: ", err)
+		}
 	}
 	boardID := os.Getenv("NAVER_BOARD_ID") // 크롤링할 게시판 ID
 
@@ -44,8 +133,87 @@ func main() {
 	// pageSize 설정 (기본값: 10)
 	pageSize := 15
 
-	fmt.Println("🚀 네이버 카페 크롤링 시작...")
-	posts, err := crawling.CrawlBoard(cafeId, boardID, cookie, maxPages, pageSize)
+	if *countPages {
+		pages, err := crawling.CountBoardPages(cafeId, boardID, pageSize, session)
+		if err != nil {
+			log.Fatal("❌ 페이지 수 확인 실패:", err)
+		}
+		fmt.Printf("📄 카페 %s 게시판 %s: 전체 %d페이지 (페이지당 %d개)\n", cafeId, boardID, pages, pageSize)
+		return
+	}
+
+	redactor := redact.NewIf(*redactPII, os.Getenv("NAVER_REDACT_SALT"))
+	if redactor != nil {
+		log.Printf("🔒 개인정보 가리기: %s", redactor.Mode())
+	}
+	textOptions := textproc.FeatureOptions(*textFeatures, *stripEmoji)
+	chunkOptions := chunk.OptionsIf(*chunks, chunk.Options{
+		MaxChars:        *chunkChars,
+		MaxTokens:       *chunkTokens,
+		Overlap:         *chunkOverlap,
+		IncludeComments: *chunkComments,
+	})
+
+	// 회원이 지정되어 있으면 해당 회원의 작성글/댓글만 크롤링
+	memberKey := os.Getenv("NAVER_MEMBER_KEY")
+	nickName := os.Getenv("NAVER_MEMBER_NICKNAME")
+	if memberKey != "" || nickName != "" {
+		fmt.Println("👤 네이버 카페 회원 크롤링 시작...")
+		result, err := crawling.CrawlMember(cafeId, crawling.CafeMember{MemberKey: memberKey, NickName: nickName}, session,
+			crawling.CafeMemberOptions{MaxPages: maxPages, Redact: redactor, Text: textOptions, Chunks: chunkOptions})
+		if err != nil {
+			log.Fatal("❌ 크롤링 중 오류 발생:", err)
+		}
+		fmt.Printf("✅ 크롤링 완료! %s(%s): 작성글 %d개, 댓글 %d개 수집\n",
+			result.NickName, result.MemberKey, len(result.Articles), len(result.Comments))
+		if redactor != nil {
+			log.Printf("🔒 가린 개인정보: %s", redactor.Summary())
+		}
+		return
+	}
+
+	var index *dedup.Index
+	if *dedupIndex != "" {
+		if index, err = dedup.Load(*dedupIndex); err != nil {
+			log.Fatal("❌ ", err)
+		}
+		log.Printf("♻️ 중복 인덱스: %s (%d개 게시글)", *dedupIndex, index.Len())
+	}
+
+	var posts []map[string]interface{}
+	if query := os.Getenv("NAVER_SEARCH_QUERY"); query != "" {
+		// 검색어가 설정되어 있으면 카페 내 검색 결과만 크롤링
+		var opts crawling.CafeSearchOptions
+		if opts, err = searchOptionsFromEnv(query); err != nil {
+			log.Fatal("❌ 검색 조건 오류:", err)
+		}
+		opts.MenuID = boardID
+		opts.MaxPages = maxPages
+		opts.Dedup, opts.Redact, opts.Text, opts.Chunks = index, redactor, textOptions, chunkOptions
+
+		fmt.Println("🔍 네이버 카페 검색 크롤링 시작...")
+		posts, err = crawling.CrawlSearch(cafeId, opts, session)
+	} else {
+		fmt.Println("🚀 네이버 카페 크롤링 시작...")
+		posts, err = crawling.CrawlBoard(cafeId, boardID, session, crawling.CafeCrawlOptions{
+			StartPage:     *startPage,
+			EndPage:       *endPage,
+			MaxPages:      maxPages,
+			PageSize:      pageSize,
+			ListWorkers:   *listWorkers,
+			DetailWorkers: *detailWorkers,
+			Dedup:         index,
+			Redact:        redactor,
+			Text:          textOptions,
+			Chunks:        chunkOptions,
+		})
+	}
+	if err == nil && index != nil {
+		err = index.Save()
+	}
+	if err == nil && redactor != nil {
+		log.Printf("🔒 가린 개인정보: %s", redactor.Summary())
+	}
 	if err != nil {
 		log.Fatal("❌ 크롤링 중 오류 발생:", err)
 	}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"naverCrawler/internal/chunk"
+	"naverCrawler/internal/config"
+	"naverCrawler/internal/crawling"
+	"naverCrawler/internal/dedup"
+
+	"github.com/joho/godotenv"
+)
+
+var (
+	configPath   = flag.String("config", "", "소스 목록 설정 파일 (YAML, 비어있으면 NAVER_BLOG_ID 등 환경 변수로 소스 하나)")
+	sourceNames  = flag.String("sources", "", "쉼표로 구분한 소스 이름만 크롤링 (비어있으면 꺼져 있지 않은 모든 소스)")
+	checkOnly    = flag.Bool("check", false, "설정만 확인하고 크롤링할 소스 목록을 출력한 뒤 종료")
+	recordDir    = flag.String("record", "", "네이버 응답 원본을 저장할 카세트 디렉토리")
+	replayDir    = flag.String("replay", "", "네트워크 대신 응답을 재생할 카세트 디렉토리")
+	maxPages     = flag.Int("max-pages", 0, "모든 소스의 최대 페이지 수 덮어쓰기")
+	dedupIndex   = flag.String("dedup-index", "", "중복 인덱스 파일 덮어쓰기")
+	redactPII    = flag.Bool("redact", false, "개인정보 가리기 덮어쓰기")
+	textFeatures = flag.Bool("text-features", false, "본문 문장/토큰/이모지 저장 덮어쓰기")
+	stripEmoji   = flag.Bool("strip-emoji", false, "분석용 본문 이모지 지우기 덮어쓰기")
+	chunks       = flag.Bool("chunks", false, "임베딩용 청크 저장 덮어쓰기 (설정 파일에 chunks가 없으면 기본 크기)")
+)
+
+func init() {
+	log.SetFlags(log.LstdFlags | log.Lmicroseconds)
+}
+
+// 명령줄에서 직접 지정한 플래그만 설정에 덮어쓰기
+func applyFlags(cfg *config.Config) {
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "max-pages":
+			for i := range cfg.Sources {
+				cfg.Sources[i].MaxPages = *maxPages
+			}
+		case "dedup-index":
+			cfg.Output.DedupIndex = *dedupIndex
+		case "redact":
+			cfg.Output.Redact = *redactPII
+		case "text-features":
+			cfg.Output.TextFeatures = *textFeatures
+		case "strip-emoji":
+			cfg.Output.StripEmoji = *stripEmoji
+		case "chunks":
+			switch {
+			case !*chunks:
+				cfg.Output.Chunks = nil
+			case cfg.Output.Chunks == nil:
+				cfg.Output.Chunks = &config.Chunks{
+					MaxChars: chunk.DefaultOptions.MaxChars,
+					Overlap:  chunk.DefaultOptions.Overlap,
+				}
+			}
+		}
+	})
+}
+
+func main() {
+	flag.Parse()
+
+	err := godotenv.Load()
+	if err != nil && !os.IsNotExist(err) {
+		fmt.Println("Error loading .env file:", err)
+		return
+	}
+
+	var cfg *config.Config
+	if *configPath != "" {
+		if cfg, err = config.Load(*configPath); err != nil {
+			log.Fatal("❌ ", err)
+		}
+	} else {
+		cfg = config.FromEnv()
+	}
+	if err := cfg.ApplyEnv(); err != nil {
+		log.Fatal("❌ ", err)
+	}
+	applyFlags(cfg)
+	if err := cfg.Validate(); err != nil {
+		log.Fatal("❌ ", err)
+	}
+
+	var names []string
+	for _, name := range strings.Split(*sourceNames, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	sources, err := cfg.Selected(names)
+	if err != nil {
+		log.Fatal("❌ ", err)
+	}
+
+	needsSession := false
+	for _, src := range sources {
+		needsSession = needsSession || src.NeedsSession()
+	}
+
+	if *checkOnly {
+		fmt.Printf("✅ 설정 확인 완료: 소스 %d개\n", len(sources))
+		for _, src := range sources {
+			fmt.Printf("  - %s (%s)\n", src.Label(), src.Type)
+		}
+		if needsSession {
+			if _, err := cfg.Session(); err != nil {
+				log.Fatal("❌ ", err)
+			}
+		}
+		return
+	}
+
+	httpConfig, err := cfg.HTTPConfig()
+	if err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if err := crawling.ConfigureHTTP(httpConfig); err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if err := crawling.UseCassette(*recordDir, *replayDir); err != nil {
+		log.Fatal("❌ ", err)
+	}
+	cfg.ApplyRateLimits()
+
+	var session *crawling.Session
+	if needsSession {
+		session, err = cfg.Session()
+		if *replayDir != "" {
+			// 재생 모드에서는 녹화된 응답을 쓰므로 쿠키가 없어도 되고 만료 여부도 확인하지 않음
+			// 쿠키 설정이 아예 없을 때만 빈 세션을 쓰고, 쿠키 파일을 읽지 못하는 등 다른 오류는 그대로 알림
+			if errors.Is(err, crawling.ErrNoSessionEnv) {
+				session = crawling.NewSession("")
+			} else if err != nil {
+				log.Fatal("❌ ", err)
+			}
+		} else {
+			if err != nil {
+				log.Fatal("❌ ", err)
+			}
+			if err := session.Verify(); err != nil {
+				log.Fatal("❌ 세션 확인 실패: ", err)
+			}
+		}
+	}
+
+	var st config.Stages
+	if cfg.Output.DedupIndex != "" {
+		if st.Dedup, err = dedup.Load(cfg.Output.DedupIndex); err != nil {
+			log.Fatal("❌ ", err)
+		}
+		log.Printf("♻️ 중복 인덱스: %s (%d개 게시글)", cfg.Output.DedupIndex, st.Dedup.Len())
+	}
+	if st.Redact = cfg.Redactor(); st.Redact != nil {
+		log.Printf("🔒 개인정보 가리기: %s", st.Redact.Mode())
+	}
+	st.Text = cfg.Output.TextOptions()
+	st.Chunks = cfg.Output.ChunkOptions()
+
+	log.Printf("🎯 크롤링할 소스 %d개", len(sources))
+	var failed []string
+	total := 0
+	for i, src := range sources {
+		log.Printf("━━━ [%d/%d] %s ━━━", i+1, len(sources), src.Label())
+		n, err := cfg.Crawl(src, session, st)
+		if err != nil {
+			log.Printf("❌ %s 실패: %v", src.Label(), err)
+			failed = append(failed, src.Label())
+			continue
+		}
+		total += n
+		// 다음 소스에서 실패해도 여기까지 수집한 게시글은 다시 수집하지 않도록 바로 저장
+		if st.Dedup != nil {
+			if err := st.Dedup.Save(); err != nil {
+				log.Fatal("❌ ", err)
+			}
+		}
+	}
+
+	if st.Redact != nil {
+		log.Printf("🔒 가린 개인정보: %s", st.Redact.Summary())
+	}
+	fmt.Printf("✅ 크롤링 완료! 소스 %d개에서 총 %d개 게시글 수집\n", len(sources)-len(failed), total)
+	if len(failed) > 0 {
+		log.Fatalf("❌ 실패한 소스 %d개: %s", len(failed), strings.Join(failed, ", "))
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"naverCrawler/internal/config"
+	"naverCrawler/internal/crawling"
+	"naverCrawler/internal/scheduler"
+
+	"github.com/joho/godotenv"
+)
+
+var (
+	configPath = flag.String("config", "naver.yaml", "소스 목록과 일정(sources[].schedule)이 있는 설정 파일 (YAML)")
+	runJob     = flag.String("run", "", "일정과 관계없이 이 소스만 바로 실행하고 종료 (소스 이름)")
+	history    = flag.Int("history", 0, "최근 실행 기록 N개를 출력하고 종료 (-run과 함께 쓰면 그 소스만)")
+)
+
+func init() {
+	log.SetFlags(log.LstdFlags | log.Lmicroseconds)
+}
+
+func printHistory(s *scheduler.Scheduler, job string, limit int) {
+	runs, err := s.History().Recent(job, limit)
+	if err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if len(runs) == 0 {
+		fmt.Println("실행 기록이 없습니다.")
+		return
+	}
+	for _, run := range runs {
+		fmt.Printf("%s  %-20s %-8s %-7s %6s  새 게시글 %d개",
+			run.StartedAt.Format("2006-01-02 15:04:05"), run.Job, run.Trigger, run.Status,
+			run.Duration().Round(time.Second), run.Posts)
+		if run.Error != "" {
+			fmt.Printf("  (%s)", run.Error)
+		}
+		fmt.Println()
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	err := godotenv.Load()
+	if err != nil && !os.IsNotExist(err) {
+		fmt.Println("Error loading .env file:", err)
+		return
+	}
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if err := cfg.ApplyEnv(); err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatal("❌ ", err)
+	}
+
+	runner := &scheduler.NaverRunner{Config: cfg}
+	s, err := scheduler.New(cfg, runner)
+	if err != nil {
+		log.Fatal("❌ ", err)
+	}
+
+	if *history > 0 {
+		printHistory(s, *runJob, *history)
+		return
+	}
+
+	httpConfig, err := cfg.HTTPConfig()
+	if err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if err := crawling.ConfigureHTTP(httpConfig); err != nil {
+		log.Fatal("❌ ", err)
+	}
+	cfg.ApplyRateLimits()
+
+	// 실행할 소스 중 카페/검색이 있을 때만 로그인 세션 확인
+	needsSession := false
+	for _, src := range cfg.Sources {
+		if src.Label() == *runJob || (*runJob == "" && src.Schedule != "" && !src.Disabled) {
+			needsSession = needsSession || src.NeedsSession()
+		}
+	}
+	if needsSession {
+		if runner.Session, err = cfg.Session(); err != nil {
+			log.Fatal("❌ ", err)
+		}
+		if err := runner.Session.Verify(); err != nil {
+			log.Fatal("❌ 세션 확인 실패: ", err)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if *runJob != "" {
+		run, err := s.RunNow(ctx, *runJob)
+		if err != nil {
+			log.Fatal("❌ ", err)
+		}
+		if run.Status != scheduler.RunOK {
+			log.Fatalf("❌ %s 실행 실패: %s", run.Job, run.Error)
+		}
+		return
+	}
+
+	log.Printf("📅 스케줄러 시작: 소스 %d개, 상태 디렉토리 %s", len(s.Jobs()), scheduler.StateDir(cfg))
+	s.Start(ctx)
+	log.Printf("✅ 스케줄러 종료")
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"naverCrawler/internal/crawling"
+	"naverCrawler/internal/server"
+
+	"github.com/joho/godotenv"
+)
+
+var (
+	addr      = flag.String("addr", "127.0.0.1:8080", "API 서버 주소 (외부에 열려면 :8080처럼 지정)")
+	recordDir = flag.String("record", "", "네이버 응답 원본을 저장할 카세트 디렉토리")
+	replayDir = flag.String("replay", "", "네트워크 대신 응답을 재생할 카세트 디렉토리")
+	workers   = flag.Int("workers", 2, "동시에 실행할 크롤링 작업 수")
+	queueSize = flag.Int("queue-size", 100, "대기열 크기 (가득 차면 새 작업 거절)")
+	maxDirect = flag.Int("max-direct", 4, "게시글 즉시 조회 동시 요청 수")
+	keepJobs  = flag.Int("keep-jobs", 100, "메모리에 남겨 둘 끝난 작업 수")
+)
+
+func init() {
+	log.SetFlags(log.LstdFlags | log.Lmicroseconds)
+}
+
+func main() {
+	flag.Parse()
+
+	err := godotenv.Load()
+	if err != nil && !os.IsNotExist(err) {
+		fmt.Println("Error loading .env file:", err)
+		return
+	}
+
+	httpConfig, err := crawling.HTTPConfigFromEnv()
+	if err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if err := crawling.ConfigureHTTP(httpConfig); err != nil {
+		log.Fatal("❌ ", err)
+	}
+	if err := crawling.UseCassette(*recordDir, *replayDir); err != nil {
+		log.Fatal("❌ ", err)
+	}
+
+	// /healthz를 뺀 모든 API 요청에 필요한 토큰
+	token := os.Getenv("NAVER_API_TOKEN")
+	if token == "" {
+		log.Fatal("❌ NAVER_API_TOKEN 환경 변수가 설정되지 않았습니다 (API 요청의 Authorization: Bearer 토큰)")
+	}
+
+	// 카페 작업에만 필요하므로 환경 변수가 없으면 세션 없이 시작
+	session, err := crawling.SessionFromEnv()
+	if errors.Is(err, crawling.ErrNoSessionEnv) {
+		session, err = nil, nil
+	}
+	if err != nil {
+		log.Fatal("❌ ", err)
+	}
+	switch {
+	case session == nil:
+		log.Printf("⚠️ NAVER_COOKIE 또는 NAVER_COOKIE_FILE이 없어 카페 작업/조회는 거절됩니다")
+	case *replayDir == "":
+		// 만료된 세션으로 시작하면 카페 작업이 모두 실패하므로 시작할 때 확인 (재생 모드는 녹화된 응답을 쓰므로 확인하지 않음)
+		if err := session.Verify(); err != nil {
+			log.Fatal("❌ 세션 확인 실패: ", err)
+		}
+	}
+
+	srv := server.New(server.Config{
+		Workers:         *workers,
+		QueueSize:       *queueSize,
+		MaxDirect:       *maxDirect,
+		MaxFinishedJobs: *keepJobs,
+		Session:         session,
+		Token:           token,
+	})
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           srv.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		log.Printf("🌐 API 서버 시작: %s (작업자 %d개, 대기열 %d)", *addr, *workers, *queueSize)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("❌ ", err)
+		}
+	}()
+
+	<-ctx.Done()
+	log.Printf("🛑 종료 중... (실행 중인 작업이 끝날 때까지 기다립니다)")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("⚠️ HTTP 서버 종료 실패: %v", err)
+	}
+	srv.Close()
+	log.Printf("✅ 종료 완료")
+}
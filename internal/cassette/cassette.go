@@ -0,0 +1,159 @@
+// Package cassette 네이버 응답 원본을 디렉토리에 녹화하고, 네트워크 없이 그대로 재생하는 http.RoundTripper
+package cassette
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Mode 카세트 동작 방식
+type Mode int
+
+const (
+	Record Mode = iota // 실제 요청을 보내고 응답을 저장
+	Replay             // 저장된 응답만 사용 (네트워크 요청 없음)
+)
+
+// 녹화된 요청 하나의 메타데이터 (<key>.json), 본문은 <key><ext> 파일에 원본 그대로 저장
+type entry struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	BodyFile   string      `json:"body_file"`
+}
+
+// Transport 녹화/재생 RoundTripper
+type Transport struct {
+	Dir  string
+	Mode Mode
+	Next http.RoundTripper // 녹화 시 실제 요청을 보낼 Transport
+}
+
+// NewRecorder next로 요청을 보내고 모든 응답을 dir에 저장
+func NewRecorder(dir string, next http.RoundTripper) (*Transport, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("카세트 디렉토리 생성 실패: %v", err)
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Transport{Dir: dir, Mode: Record, Next: next}, nil
+}
+
+// NewReplayer dir에 녹화된 응답만으로 요청에 응답
+func NewReplayer(dir string) (*Transport, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("카세트 디렉토리 확인 실패: %v", err)
+	}
+	return &Transport{Dir: dir, Mode: Replay}, nil
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := Key(req.Method, req.URL)
+	if t.Mode == Replay {
+		return t.replay(req, key)
+	}
+	return t.record(req, key)
+}
+
+func (t *Transport) record(req *http.Request, key string) (*http.Response, error) {
+	resp, err := t.Next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("응답 읽기 실패: %v", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	e := entry{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		BodyFile:   key + bodyExt(resp.Header.Get("Content-Type")),
+	}
+	// 쿠키 등 민감한 헤더는 저장하지 않음
+	e.Header.Del("Set-Cookie")
+
+	if err := os.WriteFile(filepath.Join(t.Dir, e.BodyFile), body, 0644); err != nil {
+		return nil, fmt.Errorf("카세트 본문 저장 실패: %v", err)
+	}
+	meta, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("카세트 메타데이터 변환 실패: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(t.Dir, key+".json"), meta, 0644); err != nil {
+		return nil, fmt.Errorf("카세트 메타데이터 저장 실패: %v", err)
+	}
+
+	return resp, nil
+}
+
+func (t *Transport) replay(req *http.Request, key string) (*http.Response, error) {
+	meta, err := os.ReadFile(filepath.Join(t.Dir, key+".json"))
+	if err != nil {
+		return nil, fmt.Errorf("카세트에 없는 요청입니다: %s %s", req.Method, req.URL)
+	}
+
+	var e entry
+	if err := json.Unmarshal(meta, &e); err != nil {
+		return nil, fmt.Errorf("카세트 메타데이터 파싱 실패 (%s): %v", key, err)
+	}
+	body, err := os.ReadFile(filepath.Join(t.Dir, e.BodyFile))
+	if err != nil {
+		return nil, fmt.Errorf("카세트 본문 읽기 실패 (%s): %v", key, err)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// Key 요청을 식별하는 파일 이름 (메서드 + 쿼리 파라미터를 정렬한 URL의 해시)
+func Key(method string, u *url.URL) string {
+	normalized := *u
+	normalized.RawQuery = u.Query().Encode()
+	normalized.Fragment = ""
+
+	sum := sha256.Sum256([]byte(method + " " + normalized.String()))
+	return hostPrefix(u.Host) + "_" + hex.EncodeToString(sum[:8])
+}
+
+// 파일 목록만 봐도 어느 서비스의 응답인지 알 수 있도록 호스트 이름을 접두어로 사용
+func hostPrefix(host string) string {
+	host = strings.Split(host, ":")[0]
+	return strings.ReplaceAll(host, ".", "-")
+}
+
+func bodyExt(contentType string) string {
+	switch {
+	case strings.Contains(contentType, "html"):
+		return ".html"
+	case strings.Contains(contentType, "json"):
+		return ".body.json"
+	default:
+		return ".txt"
+	}
+}
@@ -0,0 +1,90 @@
+package cassette
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestRecordReplay(t *testing.T) {
+	dir := t.TempDir()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "secret=1")
+		io.WriteString(w, `{"page":"`+r.URL.Query().Get("page")+`"}`)
+	}))
+
+	recorder, err := NewRecorder(dir, server.Client().Transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recording := &http.Client{Transport: recorder}
+	for _, path := range []string{"/list?page=1&size=5", "/list?page=2&size=5", "/missing"} {
+		resp, err := recording.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("record %s: %v", path, err)
+		}
+		io.ReadAll(resp.Body)
+		resp.Body.Close()
+	}
+	server.Close()
+
+	replayer, err := NewReplayer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replaying := &http.Client{Transport: replayer}
+
+	tests := []struct {
+		path       string
+		wantStatus int
+		wantBody   string
+	}{
+		// 쿼리 파라미터 순서가 달라도 같은 요청
+		{"/list?size=5&page=1", http.StatusOK, `{"page":"1"}`},
+		{"/list?page=2&size=5", http.StatusOK, `{"page":"2"}`},
+		{"/missing", http.StatusNotFound, "404 page not found\n"},
+	}
+	for _, tt := range tests {
+		resp, err := replaying.Get(server.URL + tt.path)
+		if err != nil {
+			t.Fatalf("replay %s: %v", tt.path, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode != tt.wantStatus || string(body) != tt.wantBody {
+			t.Errorf("replay %s = %d %q, want %d %q", tt.path, resp.StatusCode, body, tt.wantStatus, tt.wantBody)
+		}
+		if resp.Header.Get("Set-Cookie") != "" {
+			t.Errorf("replay %s leaked Set-Cookie header", tt.path)
+		}
+	}
+
+	if _, err := replaying.Get(server.URL + "/list?page=3&size=5"); err == nil {
+		t.Error("expected error for request missing from cassette")
+	}
+}
+
+func TestKey(t *testing.T) {
+	a, _ := url.Parse("https://blog.naver.com/PostView.naver?blogId=a&logNo=1")
+	b, _ := url.Parse("https://blog.naver.com/PostView.naver?logNo=1&blogId=a")
+	c, _ := url.Parse("https://blog.naver.com/PostView.naver?blogId=a&logNo=2")
+
+	if Key("GET", a) != Key("GET", b) {
+		t.Error("query order should not change the key")
+	}
+	if Key("GET", a) == Key("GET", c) {
+		t.Error("different requests should have different keys")
+	}
+	if Key("GET", a) == Key("POST", a) {
+		t.Error("method should be part of the key")
+	}
+}
@@ -0,0 +1,295 @@
+// Package chunk 게시글 본문(과 댓글)을 임베딩/RAG용으로 문단 단위를 살려 겹치게 나누고 JSONL로 저장
+package chunk
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"naverCrawler/internal/textproc"
+)
+
+// 청크 종류
+const (
+	KindContent  = "content"
+	KindComments = "comments"
+)
+
+// Options 청크 크기
+// MaxChars와 MaxTokens를 모두 지정하면 둘 다 넘지 않도록 나누고, 둘 다 0이면 DefaultOptions.MaxChars 사용
+type Options struct {
+	MaxChars  int // 청크당 최대 글자 수 (0은 제한 없음)
+	MaxTokens int // 청크당 최대 토큰 수 (0은 제한 없음)
+	Overlap   int // 앞 청크 끝에서 다음 청크 앞에 다시 넣을 최대 글자 수 (문단/문장 단위로만 겹침)
+
+	IncludeComments bool // 댓글도 따로 청크로 만듦
+
+	// 토큰 수 계산 (nil이면 EstimateTokens, 임베딩 모델의 토크나이저가 있으면 지정)
+	CountTokens func(string) int
+}
+
+// DefaultOptions 기본 청크 크기
+var DefaultOptions = Options{MaxChars: 1000, Overlap: 150}
+
+// OptionsIf enabled면 opts, 아니면 nil (청크 저장 안 함)
+func OptionsIf(enabled bool, opts Options) *Options {
+	if !enabled {
+		return nil
+	}
+	return &opts
+}
+
+// Comment 댓글
+type Comment struct {
+	Writer  string
+	Content string
+}
+
+// Document 청크로 나눌 게시글
+type Document struct {
+	Source     string // blog, cafe
+	ID         string // 출처 안에서 유일한 ID (예: allminwon/223428124420)
+	URL        string
+	Title      string
+	Writer     string
+	WriteDate  string
+	Paragraphs []string
+	Comments   []Comment
+}
+
+// Chunk 임베딩할 본문 조각과 출처 정보 (JSONL 한 줄)
+type Chunk struct {
+	ID         string `json:"id"` // 출처:게시글ID#순번
+	Source     string `json:"source"`
+	PostID     string `json:"post_id"`
+	URL        string `json:"url"`
+	Title      string `json:"title"`
+	Writer     string `json:"writer"`
+	WriteDate  string `json:"write_date"`
+	Kind       string `json:"kind"`        // content, comments
+	ChunkIndex int    `json:"chunk_index"` // 게시글 안에서 0부터
+	ChunkCount int    `json:"chunk_count"` // 게시글의 전체 청크 수
+	Text       string `json:"text"`
+	Chars      int    `json:"chars"`
+	Tokens     int    `json:"tokens"`
+}
+
+// EstimateTokens 대략적인 토큰 수
+// 한글/한자/이모지는 글자당 1개, 영문/숫자는 4글자당 1개, 문장부호는 1개로 계산
+func EstimateTokens(s string) int {
+	tokens, run := 0, 0
+	flush := func() {
+		tokens += (run + 3) / 4
+		run = 0
+	}
+	for _, r := range s {
+		switch {
+		case r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			run++
+		case unicode.IsSpace(r):
+			flush()
+		default:
+			flush()
+			tokens++
+		}
+	}
+	flush()
+	return tokens
+}
+
+// 청크를 이루는 문단/문장/단어 (sep는 앞 단위와 이을 때 쓰는 구분자)
+type unit struct {
+	text string
+	sep  string
+}
+
+type splitter struct {
+	opts  Options
+	count func(string) int
+}
+
+func (s *splitter) fits(text string) bool {
+	if s.opts.MaxChars > 0 && utf8.RuneCountInString(text) > s.opts.MaxChars {
+		return false
+	}
+	if s.opts.MaxTokens > 0 && s.count(text) > s.opts.MaxTokens {
+		return false
+	}
+	return true
+}
+
+func render(units []unit) string {
+	var b strings.Builder
+	for i, u := range units {
+		if i > 0 {
+			b.WriteString(u.sep)
+		}
+		b.WriteString(u.text)
+	}
+	return b.String()
+}
+
+// 크기를 넘는 문단은 문장으로, 문장은 단어로, 단어는 글자로 나눔
+func (s *splitter) units(paragraphs []string, paragraphSep string) []unit {
+	var units []unit
+	for _, p := range paragraphs {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		parts := s.split(p)
+		for i, part := range parts {
+			sep := " "
+			if i == 0 {
+				sep = paragraphSep
+			}
+			units = append(units, unit{text: part, sep: sep})
+		}
+	}
+	return units
+}
+
+func (s *splitter) split(p string) []string {
+	if s.fits(p) {
+		return []string{p}
+	}
+	var parts []string
+	for _, sentence := range textproc.SplitSentences(p) {
+		if s.fits(sentence) {
+			parts = append(parts, sentence)
+			continue
+		}
+		parts = append(parts, s.pack(strings.Fields(sentence), " ")...)
+	}
+	return parts
+}
+
+// 단어를 크기 안에서 이어 붙임 (한 단어가 넘치면 글자 단위로 자름)
+func (s *splitter) pack(words []string, sep string) []string {
+	var parts []string
+	cur := ""
+	for _, w := range words {
+		if !s.fits(w) {
+			if cur != "" {
+				parts = append(parts, cur)
+				cur = ""
+			}
+			parts = append(parts, s.cutRunes(w)...)
+			continue
+		}
+		if cur == "" {
+			cur = w
+		} else if s.fits(cur + sep + w) {
+			cur += sep + w
+		} else {
+			parts = append(parts, cur)
+			cur = w
+		}
+	}
+	if cur != "" {
+		parts = append(parts, cur)
+	}
+	return parts
+}
+
+func (s *splitter) cutRunes(w string) []string {
+	var parts []string
+	runes := []rune(w)
+	for len(runes) > 0 {
+		n := 1
+		for n < len(runes) && s.fits(string(runes[:n+1])) {
+			n++
+		}
+		parts = append(parts, string(runes[:n]))
+		runes = runes[n:]
+	}
+	return parts
+}
+
+// 단위들을 크기 안에서 청크로 묶고, 다음 청크 앞에 앞 청크의 끝 단위들을 Overlap 글자만큼 다시 넣음
+func (s *splitter) chunks(units []unit) []string {
+	var texts []string
+	var cur []unit
+	for i := 0; i < len(units); {
+		u := units[i]
+		if len(cur) == 0 || s.fits(render(append(cur[:len(cur):len(cur)], u))) {
+			cur = append(cur, u)
+			i++
+			continue
+		}
+		texts = append(texts, render(cur))
+
+		// 겹칠 끝 단위들 (청크 전체는 다시 넣지 않음)
+		start, size := len(cur), 0
+		for start > 1 {
+			n := utf8.RuneCountInString(cur[start-1].text)
+			if size+n > s.opts.Overlap {
+				break
+			}
+			size += n
+			start--
+		}
+		overlap := append([]unit(nil), cur[start:]...)
+		for len(overlap) > 0 && !s.fits(render(append(overlap[:len(overlap):len(overlap)], u))) {
+			overlap = overlap[1:]
+		}
+		cur = overlap
+	}
+	if len(cur) > 0 {
+		texts = append(texts, render(cur))
+	}
+	return texts
+}
+
+// Split 게시글을 청크로 나눔 (본문 다음에 댓글, 순번은 게시글 안에서 이어짐)
+func Split(doc Document, opts Options) []Chunk {
+	if opts.MaxChars <= 0 && opts.MaxTokens <= 0 {
+		opts.MaxChars = DefaultOptions.MaxChars
+	}
+	s := &splitter{opts: opts, count: opts.CountTokens}
+	if s.count == nil {
+		s.count = EstimateTokens
+	}
+
+	type part struct {
+		kind string
+		text string
+	}
+	var parts []part
+	for _, text := range s.chunks(s.units(doc.Paragraphs, "\n\n")) {
+		parts = append(parts, part{KindContent, text})
+	}
+	if opts.IncludeComments {
+		var lines []string
+		for _, c := range doc.Comments {
+			if strings.TrimSpace(c.Content) == "" {
+				continue
+			}
+			lines = append(lines, c.Writer+": "+c.Content)
+		}
+		for _, text := range s.chunks(s.units(lines, "\n")) {
+			parts = append(parts, part{KindComments, text})
+		}
+	}
+
+	chunks := make([]Chunk, len(parts))
+	for i, p := range parts {
+		chunks[i] = Chunk{
+			ID:         fmt.Sprintf("%s:%s#%d", doc.Source, doc.ID, i),
+			Source:     doc.Source,
+			PostID:     doc.ID,
+			URL:        doc.URL,
+			Title:      doc.Title,
+			Writer:     doc.Writer,
+			WriteDate:  doc.WriteDate,
+			Kind:       p.kind,
+			ChunkIndex: i,
+			ChunkCount: len(parts),
+			Text:       p.text,
+			Chars:      utf8.RuneCountInString(p.text),
+			Tokens:     s.count(p.text),
+		}
+	}
+	return chunks
+}
@@ -0,0 +1,149 @@
+package chunk
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+var testDoc = Document{
+	Source:    "blog",
+	ID:        "allminwon/1",
+	URL:       "https://blog.naver.com/allminwon/1",
+	Title:     "제주 여행 후기",
+	Writer:    "민원이",
+	WriteDate: "2024. 4. 25. 21:03",
+	Paragraphs: []string{
+		"제주도에 다녀왔습니다.",
+		"첫날은 성산일출봉에 올랐어요. 해돋이가 정말 멋졌어요. 오후에는 우도에 갔습니다.",
+		"",
+		"마지막 날에는 동문시장에서 귤을 샀어요.",
+	},
+	Comments: []Comment{
+		{Writer: "여행자", Content: "사진이 멋지네요"},
+		{Writer: "지나가는사람", Content: ""},
+		{Writer: "제주도민", Content: "우도 땅콩 아이스크림 추천해요"},
+	},
+}
+
+func texts(chunks []Chunk) []string {
+	var out []string
+	for _, c := range chunks {
+		out = append(out, c.Text)
+	}
+	return out
+}
+
+func TestSplitParagraphs(t *testing.T) {
+	chunks := Split(testDoc, Options{MaxChars: 60})
+	want := []string{
+		"제주도에 다녀왔습니다.\n\n첫날은 성산일출봉에 올랐어요. 해돋이가 정말 멋졌어요. 오후에는 우도에 갔습니다.",
+		"마지막 날에는 동문시장에서 귤을 샀어요.",
+	}
+	if got := texts(chunks); !reflect.DeepEqual(got, want) {
+		t.Fatalf("chunks = %q, want %q", got, want)
+	}
+
+	c := chunks[1]
+	if c.ID != "blog:allminwon/1#1" || c.ChunkIndex != 1 || c.ChunkCount != 2 || c.Kind != KindContent {
+		t.Errorf("chunk = %+v", c)
+	}
+	if c.URL != testDoc.URL || c.Title != testDoc.Title || c.Writer != testDoc.Writer || c.WriteDate != testDoc.WriteDate {
+		t.Errorf("metadata = %+v", c)
+	}
+	if c.Chars != utf8.RuneCountInString(c.Text) || c.Tokens != EstimateTokens(c.Text) {
+		t.Errorf("Chars = %d, Tokens = %d", c.Chars, c.Tokens)
+	}
+}
+
+func TestSplitSentencesWithOverlap(t *testing.T) {
+	chunks := Split(testDoc, Options{MaxChars: 40, Overlap: 15})
+	// 40자를 넘는 두 번째 문단은 문장으로 나뉘고, 15자 이하인 끝 문장만 다음 청크에 다시 들어감
+	want := []string{
+		"제주도에 다녀왔습니다.\n\n첫날은 성산일출봉에 올랐어요.",
+		"해돋이가 정말 멋졌어요. 오후에는 우도에 갔습니다.",
+		"오후에는 우도에 갔습니다.\n\n마지막 날에는 동문시장에서 귤을 샀어요.",
+	}
+	if got := texts(chunks); !reflect.DeepEqual(got, want) {
+		t.Fatalf("chunks = %q, want %q", got, want)
+	}
+	for _, c := range chunks {
+		if c.Chars > 40 {
+			t.Errorf("chunk %d has %d chars", c.ChunkIndex, c.Chars)
+		}
+	}
+}
+
+func TestSplitLongWord(t *testing.T) {
+	doc := Document{Source: "cafe", ID: "12345/1", Paragraphs: []string{strings.Repeat("가", 25)}}
+	chunks := Split(doc, Options{MaxChars: 10})
+	want := []string{strings.Repeat("가", 10), strings.Repeat("가", 10), strings.Repeat("가", 5)}
+	if got := texts(chunks); !reflect.DeepEqual(got, want) {
+		t.Errorf("chunks = %q, want %q", got, want)
+	}
+}
+
+func TestSplitTokenBudget(t *testing.T) {
+	chunks := Split(testDoc, Options{MaxTokens: 20, MaxChars: 1000})
+	for _, c := range chunks {
+		if c.Tokens > 20 {
+			t.Errorf("chunk %d has %d tokens: %q", c.ChunkIndex, c.Tokens, c.Text)
+		}
+	}
+	if len(chunks) < 3 {
+		t.Errorf("got %d chunks, want the token budget to split the post", len(chunks))
+	}
+}
+
+func TestSplitComments(t *testing.T) {
+	chunks := Split(testDoc, Options{MaxChars: 1000, IncludeComments: true})
+	if len(chunks) != 2 {
+		t.Fatalf("got %d chunks, want content + comments", len(chunks))
+	}
+	c := chunks[1]
+	if c.Kind != KindComments || c.ChunkIndex != 1 || c.ChunkCount != 2 {
+		t.Errorf("comment chunk = %+v", c)
+	}
+	if want := "여행자: 사진이 멋지네요\n제주도민: 우도 땅콩 아이스크림 추천해요"; c.Text != want {
+		t.Errorf("comment text = %q, want %q", c.Text, want)
+	}
+}
+
+func TestEstimateTokens(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int
+	}{
+		{"", 0},
+		{"제주도", 3},
+		{"hello world", 4},
+		{"가격은 12000원!", 7},
+	}
+	for _, tt := range tests {
+		if got := EstimateTokens(tt.in); got != tt.want {
+			t.Errorf("EstimateTokens(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestWriteJSONL(t *testing.T) {
+	var buf bytes.Buffer
+	chunks := Split(testDoc, Options{MaxChars: 60})
+	if err := WriteJSONL(&buf, chunks); err != nil {
+		t.Fatalf("WriteJSONL: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != len(chunks) {
+		t.Fatalf("got %d lines, want %d", len(lines), len(chunks))
+	}
+	var c Chunk
+	if err := json.Unmarshal([]byte(lines[1]), &c); err != nil {
+		t.Fatalf("line is not JSON: %v", err)
+	}
+	if !reflect.DeepEqual(c, chunks[1]) {
+		t.Errorf("decoded = %+v, want %+v", c, chunks[1])
+	}
+}
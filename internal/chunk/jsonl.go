@@ -0,0 +1,43 @@
+package chunk
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// WriteJSONL 청크를 한 줄에 하나씩 JSON으로 기록
+func WriteJSONL(w io.Writer, chunks []Chunk) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	for _, c := range chunks {
+		if err := enc.Encode(c); err != nil {
+			return fmt.Errorf("청크 JSON 변환 실패: %v", err)
+		}
+	}
+	return nil
+}
+
+// SaveJSONL 청크를 JSONL 파일로 저장 (디렉토리가 없으면 생성)
+func SaveJSONL(chunks []Chunk, filename string) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return fmt.Errorf("디렉토리 생성 실패: %v", err)
+	}
+	f, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("파일 생성 실패: %v", err)
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	if err := WriteJSONL(w, chunks); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("파일 저장 실패: %v", err)
+	}
+	return f.Close()
+}
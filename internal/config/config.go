@@ -0,0 +1,262 @@
+// Package config 여러 블로그/카페 소스와 출력, HTTP, 요청 간격, 로그인 정보를 담는 YAML 설정 파일
+// (naverCrawl은 소스를 한 번 크롤링하고, naverScheduler는 schedule이 있는 소스를 일정에 맞춰 크롤링)
+//
+// 값의 우선순위는 명령줄 플래그 > 환경 변수 > 설정 파일 > 기본값
+// (환경 변수는 ApplyEnv와 HTTPConfig에서, 플래그는 각 명령에서 덮어씀)
+//
+// 설정 파일 예시는 testdata/naver.yaml 참고
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// 소스 종류
+const (
+	SourceBlog   = "blog"   // 블로그 (CrawlBlog)
+	SourceCafe   = "cafe"   // 카페 게시판 (CrawlBoard)
+	SourceSearch = "search" // 카페 검색 (CrawlSearch)
+)
+
+// Config 설정 파일 전체
+type Config struct {
+	Output      Output      `yaml:"output"`
+	HTTP        HTTP        `yaml:"http"`
+	RateLimit   RateLimit   `yaml:"rate_limit"`
+	Credentials Credentials `yaml:"credentials"`
+	Scheduler   Scheduler   `yaml:"scheduler"`
+	Sources     []Source    `yaml:"sources"`
+}
+
+// Output 결과 저장과 후처리
+type Output struct {
+	BlogDir      string  `yaml:"blog_dir"`      // 블로그 결과 디렉토리 (기본값: output_blog)
+	CafeDir      string  `yaml:"cafe_dir"`      // 카페 결과 디렉토리 (기본값: output)
+	DedupIndex   string  `yaml:"dedup_index"`   // 중복 인덱스 파일 (모든 소스가 함께 사용, 비어있으면 중복 확인 안 함)
+	Redact       bool    `yaml:"redact"`        // 개인정보 가리기
+	TextFeatures bool    `yaml:"text_features"` // 본문 문장/토큰/이모지 저장
+	StripEmoji   bool    `yaml:"strip_emoji"`   // 분석용 본문에서 이모지 지우기
+	Chunks       *Chunks `yaml:"chunks"`        // 임베딩용 청크 저장
+}
+
+// Chunks 청크 크기
+type Chunks struct {
+	MaxChars        int  `yaml:"max_chars"`
+	MaxTokens       int  `yaml:"max_tokens"`
+	Overlap         int  `yaml:"overlap"`
+	IncludeComments bool `yaml:"include_comments"`
+}
+
+// HTTP 요청 설정 (crawling.HTTPConfig의 파일 표현)
+type HTTP struct {
+	Timeout            Duration `yaml:"timeout"`
+	MaxConnsPerHost    int      `yaml:"max_conns_per_host"`
+	DisableHTTP2       bool     `yaml:"disable_http2"`
+	Proxies            []string `yaml:"proxies"`
+	ProxyCheckURL      string   `yaml:"proxy_check_url"`
+	ProxyCheckInterval Duration `yaml:"proxy_check_interval"`
+	UserAgentFile      string   `yaml:"user_agent_file"`
+}
+
+// RateLimit 요청 간격 (지정하지 않으면 crawling 기본값)
+type RateLimit struct {
+	BlogInterval *Duration `yaml:"blog_interval"`  // 블로그 요청 사이 최소 간격 (기본값: 300ms)
+	CafeMinDelay *Duration `yaml:"cafe_min_delay"` // 카페 요청 전 무작위 대기 최소값 (기본값: 1s)
+	CafeMaxDelay *Duration `yaml:"cafe_max_delay"` // 카페 요청 전 무작위 대기 최대값 (기본값: 3s)
+}
+
+// Credentials 로그인 정보 (값에 ${환경변수}를 쓰면 읽을 때 치환)
+type Credentials struct {
+	Cookie     string   `yaml:"cookie"`      // "NID_AUT=...; NID_SES=..." 형식
+	CookieFile string   `yaml:"cookie_file"` // cookies.txt 또는 JSON 쿠키 내보내기 (cookie보다 우선)
+	CookieWait Duration `yaml:"cookie_wait"` // 세션 만료 시 쿠키 파일 갱신을 기다릴 시간
+	RedactSalt string   `yaml:"redact_salt"` // 작성자 가명 처리용 비밀 값
+}
+
+// Scheduler naverScheduler 설정
+// 소스별 중복 인덱스는 output.dedup_index 대신 state_dir/{소스 이름}.dedup.json에 따로 저장
+type Scheduler struct {
+	StateDir      string `yaml:"state_dir"`      // 중복 인덱스와 실행 기록 디렉토리 (기본값: scheduler_state)
+	MaxConcurrent int    `yaml:"max_concurrent"` // 동시에 실행할 소스 수 (기본값: 1)
+	Incremental   *bool  `yaml:"incremental"`    // 이전 실행에서 수집한 게시글 제외 (기본값: true)
+}
+
+// IsIncremental 소스별 중복 인덱스 사용 여부
+func (s Scheduler) IsIncremental() bool {
+	return s.Incremental == nil || *s.Incremental
+}
+
+// Source 크롤링할 블로그, 카페 게시판, 카페 검색 하나
+type Source struct {
+	Name     string `yaml:"name"` // 비어있으면 종류와 ID로 만듦 (Label)
+	Type     string `yaml:"type"` // blog, cafe, search
+	Disabled bool   `yaml:"disabled"`
+
+	// blog
+	BlogID      string `yaml:"blog_id"`
+	Backend     string `yaml:"backend"`     // desktop, mobile (기본값: desktop)
+	Concurrency int    `yaml:"concurrency"` // 동시에 진행할 요청 수
+
+	// cafe, search
+	CafeID    string `yaml:"cafe_id"`
+	BoardID   string `yaml:"board_id"` // search에서는 검색할 게시판 (비어있으면 전체)
+	StartPage int    `yaml:"start_page"`
+	EndPage   int    `yaml:"end_page"`
+	Query     string `yaml:"query"`
+	SearchBy  string `yaml:"search_by"`  // all, title, writer, comment (기본값: all)
+	StartDate string `yaml:"start_date"` // 검색 기간 시작 (YYYY-MM-DD)
+	EndDate   string `yaml:"end_date"`   // 검색 기간 끝 (YYYY-MM-DD, 당일 포함)
+
+	// 공통
+	MaxPages int    `yaml:"max_pages"` // 0은 끝까지
+	PageSize int    `yaml:"page_size"`
+	Schedule string `yaml:"schedule"` // naverScheduler 실행 일정 cron 표현식 (예: "0 */6 * * *", "@daily", 비어있으면 일정 없음)
+}
+
+// Label 로그와 -sources 플래그에 쓰는 소스 이름
+func (s Source) Label() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	switch s.Type {
+	case SourceBlog:
+		return "blog:" + s.BlogID
+	case SourceCafe:
+		return "cafe:" + s.CafeID + "/" + s.BoardID
+	case SourceSearch:
+		return "search:" + s.CafeID + "/" + s.Query
+	}
+	return s.Type
+}
+
+// NeedsSession 로그인 세션이 필요한 소스인지
+func (s Source) NeedsSession() bool {
+	return s.Type == SourceCafe || s.Type == SourceSearch
+}
+
+// Duration "300ms", "15s", "10m" 형식의 시간
+type Duration time.Duration
+
+// UnmarshalYAML 문자열 시간 해석
+func (d *Duration) UnmarshalYAML(node *yaml.Node) error {
+	v, err := time.ParseDuration(node.Value)
+	if err != nil {
+		return fmt.Errorf("line %d: 잘못된 시간 %q (예: 300ms, 15s, 10m)", node.Line, node.Value)
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// Std time.Duration으로 변환
+func (d Duration) Std() time.Duration {
+	return time.Duration(d)
+}
+
+// Load 설정 파일 읽기 (모르는 키는 오류, ${환경변수} 치환)
+// 환경 변수/플래그를 덮어쓴 뒤 Validate로 확인
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("설정 파일 읽기 실패: %v", err)
+	}
+
+	var cfg Config
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&cfg); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("설정 파일이 비어있습니다: %s", path)
+		}
+		return nil, fmt.Errorf("설정 파일 %s 파싱 실패: %v", path, err)
+	}
+
+	cfg.Credentials.Cookie = os.ExpandEnv(cfg.Credentials.Cookie)
+	cfg.Credentials.CookieFile = os.ExpandEnv(cfg.Credentials.CookieFile)
+	cfg.Credentials.RedactSalt = os.ExpandEnv(cfg.Credentials.RedactSalt)
+	for i, p := range cfg.HTTP.Proxies {
+		cfg.HTTP.Proxies[i] = os.ExpandEnv(p)
+	}
+	return &cfg, nil
+}
+
+// FromEnv 설정 파일 없이 기존 환경 변수로 소스 하나짜리 설정 만들기
+//
+//	NAVER_BLOG_ID                           블로그 소스
+//	NAVER_CAFE_ID, NAVER_BOARD_ID           카페 게시판 소스
+//	NAVER_SEARCH_QUERY (+ NAVER_SEARCH_BY)  카페 검색 소스 (NAVER_BOARD_ID는 검색할 게시판)
+func FromEnv() *Config {
+	cfg := &Config{}
+	if blogID := os.Getenv("NAVER_BLOG_ID"); blogID != "" {
+		cfg.Sources = append(cfg.Sources, Source{Type: SourceBlog, BlogID: blogID})
+	}
+	if cafeID := os.Getenv("NAVER_CAFE_ID"); cafeID != "" {
+		src := Source{Type: SourceCafe, CafeID: cafeID, BoardID: os.Getenv("NAVER_BOARD_ID")}
+		if query := os.Getenv("NAVER_SEARCH_QUERY"); query != "" {
+			src.Type, src.Query, src.SearchBy = SourceSearch, query, os.Getenv("NAVER_SEARCH_BY")
+		}
+		cfg.Sources = append(cfg.Sources, src)
+	}
+	return cfg
+}
+
+// ApplyEnv 설정된 환경 변수로 로그인 정보 덮어쓰기
+// (NAVER_COOKIE_FILE, NAVER_COOKIE, NAVER_COOKIE_WAIT, NAVER_REDACT_SALT)
+func (c *Config) ApplyEnv() error {
+	if v := os.Getenv("NAVER_COOKIE_FILE"); v != "" {
+		c.Credentials.CookieFile = v
+	}
+	if v := os.Getenv("NAVER_COOKIE"); v != "" {
+		c.Credentials.Cookie = v
+	}
+	if v := os.Getenv("NAVER_COOKIE_WAIT"); v != "" {
+		wait, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("NAVER_COOKIE_WAIT 형식 오류: %v", err)
+		}
+		c.Credentials.CookieWait = Duration(wait)
+	}
+	if v := os.Getenv("NAVER_REDACT_SALT"); v != "" {
+		c.Credentials.RedactSalt = v
+	}
+	return nil
+}
+
+// Selected 이름(Label)으로 고른 소스 (names가 비어있으면 꺼져 있지 않은 모든 소스)
+func (c *Config) Selected(names []string) ([]Source, error) {
+	if len(names) == 0 {
+		var sources []Source
+		for _, s := range c.Sources {
+			if !s.Disabled {
+				sources = append(sources, s)
+			}
+		}
+		return sources, nil
+	}
+
+	byLabel := make(map[string]Source)
+	for _, s := range c.Sources {
+		byLabel[s.Label()] = s
+	}
+	var sources []Source
+	var unknown []string
+	for _, name := range names {
+		s, ok := byLabel[name]
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		sources = append(sources, s)
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("설정에 없는 소스: %s", strings.Join(unknown, ", "))
+	}
+	return sources, nil
+}
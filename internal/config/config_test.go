@@ -0,0 +1,268 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"naverCrawler/internal/crawling"
+)
+
+func writeFile(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "naver.yaml")
+	if err := os.WriteFile(path, []byte(body), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadExample(t *testing.T) {
+	t.Setenv("PROXY_B_HOST", "10.0.0.2")
+	t.Setenv("NAVER_REDACT_SALT", "salt-from-env")
+
+	cfg, err := Load("testdata/naver.yaml")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+
+	if cfg.Output.BlogDir != "data/blog" || cfg.Output.DedupIndex != "data/dedup.json" || !cfg.Output.Redact {
+		t.Errorf("output = %+v", cfg.Output)
+	}
+	if opts := cfg.Output.ChunkOptions(); opts == nil || opts.MaxChars != 800 || opts.Overlap != 100 || !opts.IncludeComments {
+		t.Errorf("chunk options = %+v", opts)
+	}
+	if cfg.HTTP.Proxies[1] != "socks5://10.0.0.2:1080" || cfg.Credentials.RedactSalt != "salt-from-env" {
+		t.Errorf("${VAR} not expanded: proxies %v, salt %q", cfg.HTTP.Proxies, cfg.Credentials.RedactSalt)
+	}
+	if cfg.RateLimit.BlogInterval.Std() != 500*time.Millisecond || cfg.Credentials.CookieWait.Std() != 10*time.Minute {
+		t.Errorf("durations = %v, %v", cfg.RateLimit.BlogInterval.Std(), cfg.Credentials.CookieWait.Std())
+	}
+
+	var labels []string
+	sources, err := cfg.Selected(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range sources {
+		labels = append(labels, s.Label())
+	}
+	if got, want := strings.Join(labels, ","), "blog:allminwon,minwon-mobile,cafe:12345/7,battery"; got != want {
+		t.Errorf("sources = %s, want %s", got, want)
+	}
+	if sources[0].Schedule != "0 */6 * * *" || sources[1].Schedule != "" || sources[3].Schedule != "@daily" {
+		t.Errorf("schedules = %q, %q, %q", sources[0].Schedule, sources[1].Schedule, sources[3].Schedule)
+	}
+	if cfg.Scheduler.StateDir != "data/scheduler" || cfg.Scheduler.MaxConcurrent != 2 || !cfg.Scheduler.IsIncremental() {
+		t.Errorf("scheduler = %+v", cfg.Scheduler)
+	}
+
+	search, err := cfg.SearchOptions(sources[3])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if search.SearchBy != crawling.SearchByTitle || search.OutputDir != "data/cafe" ||
+		search.StartDate.Format("2006-01-02") != "2024-01-01" || search.EndDate.Format("2006-01-02 15:04:05") != "2024-03-31 23:59:59" {
+		t.Errorf("search options = %+v", search)
+	}
+	blog, err := cfg.BlogOptions(sources[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blog.Backend.Name() != "mobile" || blog.PageSize != 20 || blog.OutputDir != "data/blog" {
+		t.Errorf("blog options = %+v", blog)
+	}
+	if cafe := cfg.CafeOptions(sources[2]); cafe.EndPage != 5 || cafe.PageSize != 15 || cafe.OutputDir != "data/cafe" {
+		t.Errorf("cafe options = %+v", cafe)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"empty", "", "비어있습니다"},
+		{"unknown key", "sources:\n  - type: blog\n    blogid: a\n", "field blogid not found"},
+		{"bad duration", "http:\n  timeout: 15 seconds\n", `line 2: 잘못된 시간 "15 seconds"`},
+		{"bad yaml", "sources: [", "파싱 실패"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(writeFile(t, tt.body))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Load error = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cfg, err := Load(writeFile(t, `
+output:
+  chunks:
+    max_chars: -1
+rate_limit:
+  cafe_min_delay: 5s
+  cafe_max_delay: 1s
+scheduler:
+  max_concurrent: -1
+sources:
+  - type: blog
+  - type: blog
+    blog_id: a
+    backend: graphql
+  - type: cafe
+    cafe_id: "1"
+    start_page: 5
+    end_page: 2
+  - type: search
+    cafe_id: "1"
+    search_by: body
+    start_date: 2024/01/01
+  - type: rss
+  - type: blog
+    blog_id: a
+    max_pages: -1
+`))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	err = cfg.Validate()
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Validate error = %v, want *ValidationError", err)
+	}
+	want := []string{
+		"sources[0].blog_id",
+		"sources[1].backend",
+		"sources[2].board_id",
+		"sources[2]: start_page(5)가 end_page(2)보다 큽니다",
+		"sources[3].query",
+		"sources[3].search_by",
+		"sources[3].start_date",
+		"sources[4].type: 알 수 없는 종류 \"rss\"",
+		"sources[5].max_pages",
+		"sources[5]: 소스 이름 \"blog:a\"가 sources[1]와 겹칩니다",
+		"output.chunks",
+		"rate_limit: cafe_min_delay(5s)가 cafe_max_delay(1s)보다 깁니다",
+		"scheduler.max_concurrent",
+	}
+	if len(verr.Problems) != len(want) {
+		t.Errorf("problems:\n%s", strings.Join(verr.Problems, "\n"))
+	}
+	for _, w := range want {
+		if !strings.Contains(err.Error(), w) {
+			t.Errorf("missing problem %q in:\n%v", w, err)
+		}
+	}
+
+	if err := (&Config{}).Validate(); err == nil || !strings.Contains(err.Error(), "소스가 없습니다") {
+		t.Errorf("empty config error = %v", err)
+	}
+}
+
+func TestEnvOverrides(t *testing.T) {
+	cfg, err := Load(writeFile(t, `
+http:
+  timeout: 15s
+  proxies: [http://file:1]
+credentials:
+  cookie: NID_AUT=file; NID_SES=file
+  redact_salt: file-salt
+sources:
+  - type: blog
+    blog_id: a
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("NAVER_COOKIE", "NID_AUT=env; NID_SES=env")
+	t.Setenv("NAVER_COOKIE_WAIT", "5m")
+	t.Setenv("NAVER_PROXIES", "http://env:1")
+	if err := cfg.ApplyEnv(); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Credentials.Cookie != "NID_AUT=env; NID_SES=env" || cfg.Credentials.CookieWait.Std() != 5*time.Minute {
+		t.Errorf("credentials = %+v", cfg.Credentials)
+	}
+	if cfg.Credentials.RedactSalt != "file-salt" {
+		t.Errorf("unset env changed redact_salt to %q", cfg.Credentials.RedactSalt)
+	}
+
+	httpConfig, err := cfg.HTTPConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(httpConfig.Proxies, []string{"http://env:1"}) || httpConfig.Timeout != 15*time.Second {
+		t.Errorf("http config = %+v", httpConfig)
+	}
+
+	session, err := cfg.Session()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := session.CookieHeader(); got != "NID_AUT=env; NID_SES=env" {
+		t.Errorf("session cookie = %q", got)
+	}
+
+	t.Setenv("NAVER_COOKIE_WAIT", "later")
+	if err := cfg.ApplyEnv(); err == nil {
+		t.Error("expected error for invalid NAVER_COOKIE_WAIT")
+	}
+}
+
+func TestSessionWithoutCredentials(t *testing.T) {
+	// 재생 모드는 쿠키 설정이 아예 없는 경우만 빈 세션으로 대신함
+	if _, err := (&Config{}).Session(); !errors.Is(err, crawling.ErrNoSessionEnv) {
+		t.Errorf("err = %v, want ErrNoSessionEnv", err)
+	}
+	cfg := &Config{Credentials: Credentials{CookieFile: filepath.Join(t.TempDir(), "missing.txt")}}
+	if _, err := cfg.Session(); err == nil || errors.Is(err, crawling.ErrNoSessionEnv) {
+		t.Errorf("missing cookie file err = %v", err)
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("NAVER_BLOG_ID", "allminwon")
+	t.Setenv("NAVER_CAFE_ID", "12345")
+	t.Setenv("NAVER_BOARD_ID", "7")
+	t.Setenv("NAVER_SEARCH_QUERY", "")
+
+	cfg := FromEnv()
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if len(cfg.Sources) != 2 || cfg.Sources[0].Label() != "blog:allminwon" || cfg.Sources[1].Label() != "cafe:12345/7" {
+		t.Errorf("sources = %+v", cfg.Sources)
+	}
+
+	t.Setenv("NAVER_SEARCH_QUERY", "배터리")
+	if s := FromEnv().Sources[1]; s.Type != SourceSearch || s.Query != "배터리" || s.BoardID != "7" {
+		t.Errorf("search source = %+v", s)
+	}
+}
+
+func TestSelected(t *testing.T) {
+	cfg := &Config{Sources: []Source{
+		{Type: SourceBlog, BlogID: "a"},
+		{Name: "board", Type: SourceCafe, CafeID: "1", BoardID: "2", Disabled: true},
+	}}
+	sources, err := cfg.Selected([]string{"board"})
+	if err != nil || len(sources) != 1 || sources[0].Name != "board" {
+		t.Errorf("Selected(board) = %+v, %v", sources, err)
+	}
+	if _, err := cfg.Selected([]string{"blog:a", "missing"}); err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Errorf("Selected(missing) error = %v", err)
+	}
+}
@@ -0,0 +1,217 @@
+package config
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+
+	"naverCrawler/internal/chunk"
+	"naverCrawler/internal/crawling"
+	"naverCrawler/internal/dedup"
+	"naverCrawler/internal/redact"
+	"naverCrawler/internal/textproc"
+)
+
+// 카페 요청 전 무작위 대기 기본값 (crawling의 randomSleep과 같음)
+const (
+	defaultCafeMinDelay = time.Second
+	defaultCafeMaxDelay = 3 * time.Second
+)
+
+func cafeDelay(rl RateLimit) (time.Duration, time.Duration) {
+	min, max := defaultCafeMinDelay, defaultCafeMaxDelay
+	if rl.CafeMinDelay != nil {
+		min = rl.CafeMinDelay.Std()
+		if rl.CafeMaxDelay == nil && max < min {
+			max = min
+		}
+	}
+	if rl.CafeMaxDelay != nil {
+		max = rl.CafeMaxDelay.Std()
+		if rl.CafeMinDelay == nil && min > max {
+			min = max
+		}
+	}
+	return min, max
+}
+
+// HTTPConfig 파일의 HTTP 설정에 NAVER_PROXIES 등 환경 변수를 덮어쓴 crawling 설정
+func (c *Config) HTTPConfig() (crawling.HTTPConfig, error) {
+	cfg := crawling.DefaultHTTPConfig
+	cfg.Timeout = c.HTTP.Timeout.Std()
+	cfg.MaxConnsPerHost = c.HTTP.MaxConnsPerHost
+	cfg.DisableHTTP2 = c.HTTP.DisableHTTP2
+	cfg.Proxies = c.HTTP.Proxies
+	cfg.ProxyCheckURL = c.HTTP.ProxyCheckURL
+	cfg.ProxyCheckInterval = c.HTTP.ProxyCheckInterval.Std()
+	if c.HTTP.UserAgentFile != "" {
+		profiles, err := crawling.LoadUserAgentFile(c.HTTP.UserAgentFile)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.UserAgents = profiles
+	}
+	return crawling.HTTPConfigWithEnv(cfg)
+}
+
+// ApplyRateLimits 요청 간격 설정 적용 (지정한 값만)
+func (c *Config) ApplyRateLimits() {
+	rl := c.RateLimit
+	if rl.BlogInterval != nil {
+		crawling.SetBlogRateLimit(rl.BlogInterval.Std())
+	}
+	if rl.CafeMinDelay != nil || rl.CafeMaxDelay != nil {
+		min, max := cafeDelay(rl)
+		crawling.SetRequestDelay(func() {
+			d := min
+			if max > min {
+				d += time.Duration(rand.Int63n(int64(max - min)))
+			}
+			time.Sleep(d)
+		})
+		log.Printf("⏱️ 카페 요청 간격: %s~%s", min, max)
+	}
+}
+
+// Session 로그인 세션 (cookie_file이 cookie보다 우선, 둘 다 없으면 crawling.ErrNoSessionEnv)
+func (c *Config) Session() (*crawling.Session, error) {
+	cred := c.Credentials
+	if cred.CookieFile != "" {
+		session, err := crawling.LoadSession(cred.CookieFile)
+		if err != nil {
+			return nil, err
+		}
+		session.WaitForRefresh = cred.CookieWait.Std()
+		return session, nil
+	}
+	if cred.Cookie == "" {
+		return nil, fmt.Errorf("%w (설정 파일의 credentials.cookie, cookie_file도 없음)", crawling.ErrNoSessionEnv)
+	}
+	return crawling.NewSession(cred.Cookie), nil
+}
+
+// Redactor 개인정보 가리기 처리기 (가리지 않으면 nil)
+func (c *Config) Redactor() *redact.Redactor {
+	return redact.NewIf(c.Output.Redact, c.Credentials.RedactSalt)
+}
+
+// TextOptions 분석용 본문 처리 옵션 (처리하지 않으면 nil)
+func (o Output) TextOptions() *textproc.Options {
+	return textproc.FeatureOptions(o.TextFeatures, o.StripEmoji)
+}
+
+// ChunkOptions 청크 옵션 (청크를 저장하지 않으면 nil)
+func (o Output) ChunkOptions() *chunk.Options {
+	if o.Chunks == nil {
+		return nil
+	}
+	return &chunk.Options{
+		MaxChars:        o.Chunks.MaxChars,
+		MaxTokens:       o.Chunks.MaxTokens,
+		Overlap:         o.Chunks.Overlap,
+		IncludeComments: o.Chunks.IncludeComments,
+	}
+}
+
+// BlogOptions 블로그 소스의 크롤링 옵션 (Dedup, Redact, Text, Chunks는 Crawl에서 채움)
+func (c *Config) BlogOptions(s Source) (crawling.BlogCrawlOptions, error) {
+	backendName := s.Backend
+	if backendName == "" {
+		backendName = "desktop"
+	}
+	backend, err := crawling.BlogBackendByName(backendName)
+	if err != nil {
+		return crawling.BlogCrawlOptions{}, err
+	}
+	return crawling.BlogCrawlOptions{
+		MaxPages:    s.MaxPages,
+		PageSize:    s.PageSize,
+		Concurrency: s.Concurrency,
+		OutputDir:   c.Output.BlogDir,
+		Backend:     backend,
+	}, nil
+}
+
+// CafeOptions 카페 게시판 소스의 크롤링 옵션 (Dedup, Redact, Text, Chunks는 Crawl에서 채움)
+func (c *Config) CafeOptions(s Source) crawling.CafeCrawlOptions {
+	pageSize := s.PageSize
+	if pageSize <= 0 {
+		pageSize = 15
+	}
+	return crawling.CafeCrawlOptions{
+		StartPage: s.StartPage,
+		EndPage:   s.EndPage,
+		MaxPages:  s.MaxPages,
+		PageSize:  pageSize,
+		OutputDir: c.Output.CafeDir,
+	}
+}
+
+// SearchOptions 카페 검색 소스의 검색 조건 (Dedup, Redact, Text, Chunks는 Crawl에서 채움)
+func (c *Config) SearchOptions(s Source) (crawling.CafeSearchOptions, error) {
+	by, err := searchBy(s.SearchBy)
+	if err != nil {
+		return crawling.CafeSearchOptions{}, err
+	}
+	start, err := parseDate(s.StartDate)
+	if err != nil {
+		return crawling.CafeSearchOptions{}, err
+	}
+	end, err := parseDate(s.EndDate)
+	if err != nil {
+		return crawling.CafeSearchOptions{}, err
+	}
+	if !end.IsZero() {
+		// 종료일 당일 작성 글까지 포함
+		end = end.Add(24*time.Hour - time.Second)
+	}
+	return crawling.CafeSearchOptions{
+		Query:     s.Query,
+		SearchBy:  by,
+		MenuID:    s.BoardID,
+		StartDate: start,
+		EndDate:   end,
+		PageSize:  s.PageSize,
+		MaxPages:  s.MaxPages,
+		OutputDir: c.Output.CafeDir,
+	}, nil
+}
+
+// Stages 모든 소스가 함께 쓰는 후처리 단계
+type Stages struct {
+	Dedup  *dedup.Index
+	Redact *redact.Redactor
+	Text   *textproc.Options
+	Chunks *chunk.Options
+}
+
+// Crawl 소스 하나를 크롤링하고 수집한 게시글 수 반환 (session은 카페/검색 소스에만 필요)
+func (c *Config) Crawl(s Source, session *crawling.Session, st Stages) (int, error) {
+	switch s.Type {
+	case SourceBlog:
+		opts, err := c.BlogOptions(s)
+		if err != nil {
+			return 0, err
+		}
+		opts.Dedup, opts.Redact, opts.Text, opts.Chunks = st.Dedup, st.Redact, st.Text, st.Chunks
+		posts, err := crawling.CrawlBlog(s.BlogID, opts)
+		return len(posts), err
+
+	case SourceCafe:
+		opts := c.CafeOptions(s)
+		opts.Dedup, opts.Redact, opts.Text, opts.Chunks = st.Dedup, st.Redact, st.Text, st.Chunks
+		posts, err := crawling.CrawlBoard(s.CafeID, s.BoardID, session, opts)
+		return len(posts), err
+
+	case SourceSearch:
+		opts, err := c.SearchOptions(s)
+		if err != nil {
+			return 0, err
+		}
+		opts.Dedup, opts.Redact, opts.Text, opts.Chunks = st.Dedup, st.Redact, st.Text, st.Chunks
+		posts, err := crawling.CrawlSearch(s.CafeID, opts, session)
+		return len(posts), err
+	}
+	return 0, fmt.Errorf("알 수 없는 소스 종류: %s", s.Type)
+}
@@ -0,0 +1,171 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"naverCrawler/internal/crawling"
+)
+
+// ValidationError 설정 확인에서 찾은 문제 목록
+type ValidationError struct {
+	Problems []string // "위치: 문제" 형식
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("설정 오류 %d개:\n  - %s", len(e.Problems), strings.Join(e.Problems, "\n  - "))
+}
+
+type problems []string
+
+func (p *problems) add(path, format string, args ...interface{}) {
+	*p = append(*p, path+": "+fmt.Sprintf(format, args...))
+}
+
+// Validate 설정 확인 (문제를 모두 모아 *ValidationError로 반환)
+func (c *Config) Validate() error {
+	var p problems
+
+	if len(c.Sources) == 0 {
+		p.add("sources", "크롤링할 소스가 없습니다")
+	}
+	seen := make(map[string]string)
+	for i, s := range c.Sources {
+		path := fmt.Sprintf("sources[%d]", i)
+		s.validate(path, &p)
+		if prev, ok := seen[s.Label()]; ok {
+			p.add(path, "소스 이름 %q가 %s와 겹칩니다 (name으로 구분하세요)", s.Label(), prev)
+		}
+		seen[s.Label()] = path
+	}
+
+	if ch := c.Output.Chunks; ch != nil {
+		if ch.MaxChars < 0 || ch.MaxTokens < 0 || ch.Overlap < 0 {
+			p.add("output.chunks", "크기는 0 이상이어야 합니다")
+		}
+	}
+
+	if c.HTTP.Timeout < 0 || c.HTTP.ProxyCheckInterval < 0 {
+		p.add("http", "시간은 0 이상이어야 합니다")
+	}
+	if c.HTTP.MaxConnsPerHost < 0 {
+		p.add("http.max_conns_per_host", "0 이상이어야 합니다")
+	}
+
+	rl := c.RateLimit
+	for _, d := range []struct {
+		name  string
+		value *Duration
+	}{
+		{"blog_interval", rl.BlogInterval},
+		{"cafe_min_delay", rl.CafeMinDelay},
+		{"cafe_max_delay", rl.CafeMaxDelay},
+	} {
+		if d.value != nil && *d.value < 0 {
+			p.add("rate_limit."+d.name, "0 이상이어야 합니다")
+		}
+	}
+	if min, max := cafeDelay(rl); min > max {
+		p.add("rate_limit", "cafe_min_delay(%s)가 cafe_max_delay(%s)보다 깁니다", min, max)
+	}
+
+	if c.Scheduler.MaxConcurrent < 0 {
+		p.add("scheduler.max_concurrent", "0 이상이어야 합니다")
+	}
+
+	if c.Credentials.CookieWait < 0 {
+		p.add("credentials.cookie_wait", "0 이상이어야 합니다")
+	}
+
+	if len(p) > 0 {
+		return &ValidationError{Problems: p}
+	}
+	return nil
+}
+
+func (s Source) validate(path string, p *problems) {
+	switch s.Type {
+	case SourceBlog:
+		if s.BlogID == "" {
+			p.add(path+".blog_id", "블로그 소스에 필요합니다")
+		}
+		if s.Backend != "" {
+			if _, err := crawling.BlogBackendByName(s.Backend); err != nil {
+				p.add(path+".backend", "%v", err)
+			}
+		}
+	case SourceCafe:
+		if s.CafeID == "" {
+			p.add(path+".cafe_id", "카페 소스에 필요합니다")
+		}
+		if s.BoardID == "" {
+			p.add(path+".board_id", "카페 소스에 필요합니다")
+		}
+		if s.StartPage < 0 || s.EndPage < 0 {
+			p.add(path, "start_page, end_page는 0 이상이어야 합니다")
+		} else if s.EndPage > 0 && s.StartPage > s.EndPage {
+			p.add(path, "start_page(%d)가 end_page(%d)보다 큽니다", s.StartPage, s.EndPage)
+		}
+	case SourceSearch:
+		if s.CafeID == "" {
+			p.add(path+".cafe_id", "검색 소스에 필요합니다")
+		}
+		if s.Query == "" {
+			p.add(path+".query", "검색 소스에 필요합니다")
+		}
+		if _, err := searchBy(s.SearchBy); err != nil {
+			p.add(path+".search_by", "%v", err)
+		}
+		start, errStart := parseDate(s.StartDate)
+		if errStart != nil {
+			p.add(path+".start_date", "%v", errStart)
+		}
+		end, errEnd := parseDate(s.EndDate)
+		if errEnd != nil {
+			p.add(path+".end_date", "%v", errEnd)
+		}
+		if errStart == nil && errEnd == nil && !start.IsZero() && !end.IsZero() && start.After(end) {
+			p.add(path, "start_date가 end_date보다 늦습니다")
+		}
+	case "":
+		p.add(path+".type", "필요합니다 (blog, cafe, search 중 하나)")
+	default:
+		p.add(path+".type", "알 수 없는 종류 %q (blog, cafe, search 중 하나)", s.Type)
+	}
+
+	if s.MaxPages < 0 {
+		p.add(path+".max_pages", "0 이상이어야 합니다")
+	}
+	if s.PageSize < 0 {
+		p.add(path+".page_size", "0 이상이어야 합니다")
+	}
+	if s.Concurrency < 0 {
+		p.add(path+".concurrency", "0 이상이어야 합니다")
+	}
+}
+
+func searchBy(v string) (int, error) {
+	switch v {
+	case "", "all":
+		return crawling.SearchByAll, nil
+	case "title":
+		return crawling.SearchByTitle, nil
+	case "writer":
+		return crawling.SearchByWriter, nil
+	case "comment":
+		return crawling.SearchByComment, nil
+	}
+	return 0, fmt.Errorf("알 수 없는 값 %q (all, title, writer, comment 중 하나)", v)
+}
+
+func parseDate(v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", v, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("날짜 형식 오류 %q (YYYY-MM-DD)", v)
+	}
+	return t, nil
+}
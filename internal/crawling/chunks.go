@@ -0,0 +1,128 @@
+package crawling
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"naverCrawler/internal/chunk"
+	"naverCrawler/internal/utils"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// 블로그 게시글을 청크로 나눌 문서로 변환 (문단 정보가 없으면 본문 전체를 한 문단으로)
+func blogDocument(blogID string, post BlogPost) chunk.Document {
+	paragraphs := post.Paragraphs
+	if len(paragraphs) == 0 && post.Content != "" {
+		paragraphs = []string{post.Content}
+	}
+
+	var comments []chunk.Comment
+	for _, c := range post.Comments {
+		comments = append(comments, chunk.Comment{Writer: c.Writer, Content: c.Content})
+	}
+
+	return chunk.Document{
+		Source:     "blog",
+		ID:         blogID + "/" + post.ID,
+		URL:        post.OriginalURL,
+		Title:      post.Title,
+		Writer:     post.Writer,
+		WriteDate:  post.WriteDate,
+		Paragraphs: paragraphs,
+		Comments:   comments,
+	}
+}
+
+// 카페 게시글을 청크로 나눌 문서로 변환 (본문 HTML의 문단 단위)
+func cafeDocument(cafeId string, post map[string]interface{}) chunk.Document {
+	id := fmt.Sprint(post["id"])
+	title, _ := post["title"].(string)
+	writer, _ := post["writer"].(string)
+	writeDate, _ := post["write_date"].(string)
+	contentHTML, _ := post["content"].(string)
+
+	var comments []chunk.Comment
+	if items, ok := post["comments"].([]map[string]interface{}); ok {
+		for _, c := range items {
+			w, _ := c["writer"].(string)
+			content, _ := c["content"].(string)
+			comments = append(comments, chunk.Comment{Writer: w, Content: content})
+		}
+	}
+
+	return chunk.Document{
+		Source:     "cafe",
+		ID:         cafeId + "/" + id,
+		URL:        fmt.Sprintf("https://cafe.naver.com/ca-fe/cafes/%s/articles/%s", cafeId, id),
+		Title:      title,
+		Writer:     writer,
+		WriteDate:  writeDate,
+		Paragraphs: htmlParagraphs(contentHTML),
+		Comments:   comments,
+	}
+}
+
+// HTML 본문의 문단 (문단 요소가 없으면 전체 텍스트를 한 문단으로)
+func htmlParagraphs(s string) []string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(s))
+	if err != nil {
+		return []string{htmlText(s)}
+	}
+
+	var paragraphs []string
+	doc.Find("p, .se-text-paragraph, li, h1, h2, h3, h4, blockquote").Each(func(i int, sel *goquery.Selection) {
+		// 다른 문단 요소 안의 문단은 바깥 문단에 포함되므로 건너뜀
+		if sel.ParentsFiltered("p, .se-text-paragraph, li, h1, h2, h3, h4, blockquote").Length() > 0 {
+			return
+		}
+		if p := utils.CleanText(sel.Text()); p != "" {
+			paragraphs = append(paragraphs, p)
+		}
+	})
+	if len(paragraphs) == 0 {
+		if text := htmlText(s); text != "" {
+			paragraphs = []string{text}
+		}
+	}
+	return paragraphs
+}
+
+// 블로그 게시글 청크를 JSONL로 저장
+func saveBlogChunks(blogID string, posts []BlogPost, opts chunk.Options, outputDir string) error {
+	var chunks []chunk.Chunk
+	for _, post := range posts {
+		chunks = append(chunks, chunk.Split(blogDocument(blogID, post), opts)...)
+	}
+
+	timestamp := time.Now().Format("20060102_150405")
+	filename := filepath.Join(outputDir, fmt.Sprintf("blog_%s_chunks_%s.jsonl", blogID, timestamp))
+	if err := chunk.SaveJSONL(chunks, filename); err != nil {
+		return fmt.Errorf("청크 저장 실패: %v", err)
+	}
+	log.Printf("🧩 게시글 %d개를 청크 %d개로 나눠 %s 파일로 저장했습니다.", len(posts), len(chunks), filename)
+	return nil
+}
+
+// 카페 게시글 청크를 JSONL로 저장 (본문이 없는 접근 불가 게시글은 제외)
+func saveCafeChunks(cafeId string, posts []map[string]interface{}, opts chunk.Options, filename string) error {
+	var chunks []chunk.Chunk
+	for _, post := range posts {
+		if post["status"] != ArticleOK {
+			continue
+		}
+		chunks = append(chunks, chunk.Split(cafeDocument(cafeId, post), opts)...)
+	}
+
+	if err := chunk.SaveJSONL(chunks, filename); err != nil {
+		return fmt.Errorf("청크 저장 실패: %v", err)
+	}
+	log.Printf("🧩 게시글 %d개를 청크 %d개로 나눠 %s 파일로 저장했습니다.", len(posts), len(chunks), filename)
+	return nil
+}
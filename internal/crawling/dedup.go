@@ -0,0 +1,164 @@
+package crawling
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"naverCrawler/internal/dedup"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// 이미 수집한 블로그 게시글은 빼고, 남은 게시글에 유사 중복 클러스터 ID 표시
+func dedupBlogPosts(ix *dedup.Index, blogID string, posts []BlogPost) []BlogPost {
+	if ix == nil {
+		return posts
+	}
+
+	var kept []BlogPost
+	for _, post := range posts {
+		r := ix.Add("blog", blogID+"/"+post.ID, post.Title+"\n"+post.Content)
+		if r.Duplicate {
+			log.Printf("♻️ 이미 수집한 게시글 제외: %s", post.ID)
+			continue
+		}
+		if r.NearDuplicateOf != "" {
+			log.Printf("👯 게시글 %s는 %s와 유사한 게시글입니다", post.ID, r.NearDuplicateOf)
+		}
+		post.DuplicateCluster = r.ClusterID
+		kept = append(kept, post)
+	}
+	return kept
+}
+
+// 다시 볼 수 없는 블로그 게시글(삭제/비공개)을 지문 없이 인덱스에 추가
+// 목록 페이지가 모두 이미 수집한 게시글인지 판단할 때 이 게시글도 수집한 것으로 보기 위함
+// 이웃 공개/성인인증/원인 불명은 로그인 상태에 따라 달라질 수 있어 다음 실행에서 다시 시도
+func indexGoneBlogPosts(ix *dedup.Index, blogID string, posts []BlogPost) {
+	if ix == nil {
+		return
+	}
+	for _, post := range posts {
+		if post.Status == BlogPostDeleted || post.Status == BlogPostPrivate {
+			ix.Add("blog", blogID+"/"+post.ID, "")
+		}
+	}
+}
+
+// 다시 볼 수 없는 카페 게시글 (권한 없음/등급 제한은 가입이나 등급 변경으로 볼 수 있음)
+func articleGone(status interface{}) bool {
+	return status == ArticleDeleted || status == ArticleBlinded
+}
+
+// 이미 수집한 카페 게시글은 빼고, 남은 게시글에 유사 중복 클러스터 ID("duplicate_cluster") 표시
+// 인덱스에는 정상 게시글과 삭제/블라인드 게시글(지문 없이)만 추가
+// 권한 없음/등급 제한/상세 요청 실패는 다음 실행에서 다시 시도
+func dedupCafeJobs(ix *dedup.Index, cafeId string, jobs []articleJob) []articleJob {
+	if ix == nil {
+		return jobs
+	}
+
+	var kept []articleJob
+	for _, job := range jobs {
+		if job.post["status"] != ArticleOK {
+			if articleGone(job.post["status"]) && ix.Add("cafe", cafeId+"/"+fmt.Sprint(job.post["id"]), "").Duplicate {
+				log.Printf("♻️ 이미 수집한 게시글 제외: %v", job.post["id"])
+				continue
+			}
+			kept = append(kept, job)
+			continue
+		}
+		id := fmt.Sprint(job.post["id"])
+		title, _ := job.post["title"].(string)
+		contentHTML, _ := job.post["content"].(string)
+
+		r := ix.Add("cafe", cafeId+"/"+id, title+"\n"+htmlText(contentHTML))
+		if r.Duplicate {
+			log.Printf("♻️ 이미 수집한 게시글 제외: %s", id)
+			continue
+		}
+		if r.NearDuplicateOf != "" {
+			log.Printf("👯 게시글 %s는 %s와 유사한 게시글입니다", id, r.NearDuplicateOf)
+		}
+		if r.ClusterID != "" {
+			job.post["duplicate_cluster"] = r.ClusterID
+		}
+		kept = append(kept, job)
+	}
+	return kept
+}
+
+// 인덱스에 이미 있는 블로그 게시글을 상세 요청 전에 빼기
+func skipKnownBlogPosts(ix *dedup.Index, blogID string, posts []BlogPost) []BlogPost {
+	if ix == nil {
+		return posts
+	}
+	var unknown []BlogPost
+	for _, post := range posts {
+		if ix.Has("blog", blogID+"/"+post.ID) {
+			log.Printf("♻️ 이미 수집한 게시글 제외: %s", post.ID)
+			continue
+		}
+		unknown = append(unknown, post)
+	}
+	return unknown
+}
+
+// 인덱스에 이미 있는 카페 게시글을 상세 요청 전에 빼기
+func skipKnownCafePosts(ix *dedup.Index, cafeId string, posts []map[string]interface{}) []map[string]interface{} {
+	if ix == nil {
+		return posts
+	}
+	var unknown []map[string]interface{}
+	for _, post := range posts {
+		id := fmt.Sprint(post["id"])
+		if ix.Has("cafe", cafeId+"/"+id) {
+			log.Printf("♻️ 이미 수집한 게시글 제외: %s", id)
+			continue
+		}
+		unknown = append(unknown, post)
+	}
+	return unknown
+}
+
+// 목록 한 페이지의 게시글이 모두 인덱스에 있을 때 (최신순 목록이므로 이후 페이지도 이미 수집한 것으로 봄)
+var errPageCollected = errors.New("이미 수집한 게시글만 있는 페이지")
+
+// 증분 크롤링에서 모두 이미 수집한 목록 페이지가 나오면 그보다 뒤(오래된) 페이지는 가져오지 않음
+// 여러 목록 작업자가 함께 쓰며, 0이면 아직 멈출 페이지가 없음
+type pageStop struct {
+	mu   sync.Mutex
+	page int
+}
+
+// page까지 모두 이미 수집했음을 기록
+func (s *pageStop) set(page int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.page == 0 || page < s.page {
+		log.Printf("♻️ %d페이지의 게시글을 모두 이미 수집해 이후 페이지는 가져오지 않습니다", page)
+		s.page = page
+	}
+}
+
+// page가 멈춘 페이지보다 뒤인지
+func (s *pageStop) after(page int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.page != 0 && page > s.page
+}
+
+// HTML 본문에서 텍스트만 추출
+func htmlText(s string) string {
+	if s == "" {
+		return ""
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(s))
+	if err != nil {
+		return s
+	}
+	return strings.Join(strings.Fields(doc.Text()), " ")
+}
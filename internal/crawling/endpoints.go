@@ -0,0 +1,92 @@
+package crawling
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"naverCrawler/internal/cassette"
+)
+
+// Endpoints 크롤러가 요청을 보내는 네이버 서비스 기본 URL
+type Endpoints struct {
+	Blog       string // 블로그 (PostTitleListAsync, PostView)
+	MobileBlog string // 모바일 블로그 (게시글 목록 API, 모바일 PostView)
+	BlogLike   string // 블로그 공감 수 API
+	CafeAPI    string // 카페 API (목록, 상세, 검색, 회원)
+}
+
+// DefaultEndpoints 실제 네이버 서비스 주소
+var DefaultEndpoints = Endpoints{
+	Blog:       "https://blog.naver.com",
+	MobileBlog: "https://m.blog.naver.com",
+	BlogLike:   "https://blog.like.naver.com",
+	CafeAPI:    "https://apis.naver.com",
+}
+
+// 현재 사용 중인 기본 URL
+var endpoints = DefaultEndpoints
+
+// 요청 전 지연 함수 (테스트에서는 지연 없이 교체)
+var requestDelay = randomSleep
+
+// SetEndpoints 요청 기본 URL 교체 (가짜 서버, 프록시 게이트웨이 등)
+func SetEndpoints(e Endpoints) {
+	endpoints = e
+}
+
+// SetHTTPClient 모든 요청에 사용할 HTTP 클라이언트 교체
+func SetHTTPClient(c *http.Client) {
+	client = c
+}
+
+// WrapTransport 현재 클라이언트의 Transport를 감싼 Transport로 교체 (응답 녹화/재생 등)
+func WrapTransport(wrap func(next http.RoundTripper) http.RoundTripper) {
+	c := *client
+	next := c.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	c.Transport = wrap(next)
+	client = &c
+}
+
+// SetRequestDelay 요청 사이 지연 함수 교체 (nil이면 지연 없음)
+func SetRequestDelay(delay func()) {
+	if delay == nil {
+		delay = func() {}
+	}
+	requestDelay = delay
+}
+
+// UseCassette 응답 녹화(recordDir) 또는 재생(replayDir) 모드 설정 (둘 다 비어있으면 아무것도 하지 않음)
+func UseCassette(recordDir, replayDir string) error {
+	switch {
+	case recordDir != "" && replayDir != "":
+		return fmt.Errorf("녹화와 재생 모드는 함께 사용할 수 없습니다")
+
+	case recordDir != "":
+		recorder, err := cassette.NewRecorder(recordDir, nil)
+		if err != nil {
+			return err
+		}
+		WrapTransport(func(next http.RoundTripper) http.RoundTripper {
+			recorder.Next = next
+			return recorder
+		})
+		log.Printf("⏺️ 응답 녹화 모드: %s", recordDir)
+
+	case replayDir != "":
+		replayer, err := cassette.NewReplayer(replayDir)
+		if err != nil {
+			return err
+		}
+		WrapTransport(func(http.RoundTripper) http.RoundTripper {
+			return replayer
+		})
+		// 네트워크를 쓰지 않으므로 요청 간 지연도 필요 없음
+		SetRequestDelay(nil)
+		log.Printf("▶️ 응답 재생 모드: %s", replayDir)
+	}
+	return nil
+}
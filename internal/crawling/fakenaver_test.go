@@ -0,0 +1,162 @@
+package crawling
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// 테스트가 작업 디렉토리를 바꿔도 픽스처를 찾을 수 있도록 절대 경로로 보관
+var testdataDir, _ = filepath.Abs("testdata")
+
+// 가짜 네이버 서버의 응답 규칙
+type fakeRoute struct {
+	path    string            // "*"로 끝나면 접두어 일치
+	query   map[string]string // 요청에 포함되어야 하는 쿼리 파라미터 (부분 일치)
+	status  int
+	file    string // testdata 아래 파일
+	handler http.HandlerFunc
+}
+
+// 기록해둔 네이버 응답을 돌려주는 테스트 서버
+type fakeNaver struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	routes      []fakeRoute
+	requests    []*http.Request
+	latency     time.Duration // 응답마다 추가할 지연
+	inFlight    int
+	maxInFlight int // 동시에 처리 중이던 요청 수의 최댓값
+}
+
+// 가짜 서버를 띄우고 크롤러의 기본 URL, 클라이언트, 요청 지연을 교체
+func newFakeNaver(t *testing.T) *fakeNaver {
+	t.Helper()
+
+	f := &fakeNaver{}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serve))
+
+	prevEndpoints, prevClient, prevDelay := endpoints, client, requestDelay
+	SetEndpoints(Endpoints{Blog: f.URL, MobileBlog: f.URL + "/m", BlogLike: f.URL + "/like", CafeAPI: f.URL})
+	SetHTTPClient(f.Client())
+	SetRequestDelay(nil)
+	SetBlogRateLimit(0)
+
+	t.Cleanup(func() {
+		f.Close()
+		endpoints, client, requestDelay = prevEndpoints, prevClient, prevDelay
+		SetBlogRateLimit(defaultBlogRequestInterval)
+	})
+	return f
+}
+
+// 응답 규칙 추가 (먼저 추가한 규칙이 우선)
+func (f *fakeNaver) route(path string, query map[string]string, status int, file string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.routes = append(f.routes, fakeRoute{path: path, query: query, status: status, file: file})
+}
+
+// 파일 대신 직접 응답을 만드는 규칙 추가
+func (f *fakeNaver) handle(path string, handler http.HandlerFunc) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.routes = append(f.routes, fakeRoute{path: path, handler: handler})
+}
+
+// 응답 지연 설정
+func (f *fakeNaver) setLatency(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.latency = d
+}
+
+// 경로로 받은 요청 수
+func (f *fakeNaver) count(path string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n := 0
+	for _, r := range f.requests {
+		if r.URL.Path == path {
+			n++
+		}
+	}
+	return n
+}
+
+// 동시에 처리 중이던 요청 수의 최댓값
+func (f *fakeNaver) peakInFlight() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.maxInFlight
+}
+
+func (f *fakeNaver) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.requests = append(f.requests, r)
+	routes := f.routes
+	latency := f.latency
+	f.inFlight++
+	if f.inFlight > f.maxInFlight {
+		f.maxInFlight = f.inFlight
+	}
+	f.mu.Unlock()
+
+	defer func() {
+		f.mu.Lock()
+		f.inFlight--
+		f.mu.Unlock()
+	}()
+	time.Sleep(latency)
+
+	for _, route := range routes {
+		if !pathMatches(r, route.path) || !queryMatches(r, route.query) {
+			continue
+		}
+		if route.handler != nil {
+			route.handler(w, r)
+			return
+		}
+		data, err := os.ReadFile(filepath.Join(testdataDir, route.file))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		switch {
+		case strings.HasSuffix(route.file, ".html"):
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		case strings.HasSuffix(route.file, ".json"):
+			w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		default:
+			w.Header().Set("Content-Type", "text/plain;charset=UTF-8")
+		}
+		w.WriteHeader(route.status)
+		w.Write(data)
+		return
+	}
+	http.NotFound(w, r)
+}
+
+func pathMatches(r *http.Request, pattern string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(r.URL.Path, prefix)
+	}
+	return r.URL.Path == pattern
+}
+
+func queryMatches(r *http.Request, want map[string]string) bool {
+	q := r.URL.Query()
+	for k, v := range want {
+		if q.Get(k) != v {
+			return false
+		}
+	}
+	return true
+}
@@ -1,29 +1,56 @@
 package crawling
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"naverCrawler/internal/chunk"
+	"naverCrawler/internal/dedup"
+	"naverCrawler/internal/redact"
+	"naverCrawler/internal/textproc"
 	"naverCrawler/internal/utils"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/sync/errgroup"
 )
 
 // BlogPost represents a blog post.
 type BlogPost struct {
-	ID          string        `json:"id"`
-	Title       string        `json:"title"`
-	Content     string        `json:"content"`
-	Writer      string        `json:"writer"`
-	WriteDate   string        `json:"write_date"`
-	Comments    []BlogComment `json:"comments"`
-	OriginalURL string        `json:"original_url"`
+	ID          string         `json:"id"`
+	Title       string         `json:"title"`
+	Content     string         `json:"content"`
+	Writer      string         `json:"writer"`
+	WriteDate   string         `json:"write_date"`
+	Comments    []BlogComment  `json:"comments"`
+	OriginalURL string         `json:"original_url"`
+	Status      BlogPostStatus `json:"status"`
+
+	// 반응 지표
+	ReadCount    int `json:"read_count"`
+	CommentCount int `json:"comment_count"`
+	LikeCount    int `json:"like_count"` // 공감 수 (모든 공감 종류의 합)
+
+	Tags         []string `json:"tags"`
+	CategoryNo   int      `json:"category_no"`
+	CategoryName string   `json:"category_name"`
+	Thumbnail    string   `json:"thumbnail"`
+
+	DuplicateCluster string `json:"duplicate_cluster,omitempty"` // 유사 중복 클러스터 ID
+
+	Sponsored       bool              `json:"sponsored"` // 광고/협찬 게시글 여부
+	SponsorEvidence []SponsorEvidence `json:"sponsor_evidence,omitempty"`
+
+	TextFeatures *textproc.Features `json:"text_features,omitempty"` // 문장/토큰/이모지 (BlogCrawlOptions.Text를 지정한 경우)
+
+	Paragraphs []string `json:"-"` // 본문 문단 (청크 나누기용, 저장하지 않음)
 }
 
 // BlogComment represents a comment on a blog post.
@@ -32,6 +59,8 @@ type BlogComment struct {
 	Content   string `json:"content"`
 	Writer    string `json:"writer"`
 	WriteDate string `json:"write_date"`
+
+	WriterBlogID string `json:"writer_blog_id,omitempty"` // 작성자 블로그 ID (링크가 있을 때만)
 }
 
 // NaverBlogResponse represents the response from Naver Blog API
@@ -51,6 +80,9 @@ type NaverBlogResponse struct {
 	TotalCount   string `json:"totalCount"`
 }
 
+// ErrBlogPostInaccessible 비공개, 이웃공개, 성인인증, 삭제 등으로 본문을 볼 수 없는 게시글
+var ErrBlogPostInaccessible = errors.New("접근할 수 없는 게시글")
+
 // 셀렉터 상수 정의
 const (
 	writerSelectors         = ".nick_name, .blog_author .author_name, .author, .writer, .nickname, .blog_name, .blog_name, .nickname"
@@ -60,64 +92,118 @@ const (
 	commentContentSelectors = ".comment_text, .text_comment, .cmt_text, .comment_text_box"
 	commentWriterSelectors  = ".comment_nick, .author_name, .cmt_nick, .comment_nick_box"
 	commentDateSelectors    = ".comment_date, .date, .cmt_date, .comment_date_box"
+	tagSelectors            = ".wrap_tag .ell, .post_tag .ell, .tag_area .tag, .post_tag a"
+	categorySelectors       = ".blog2_series a, .blog_category a, .category_name"
 )
 
+// BlogPostPage 게시글 목록 한 페이지와 블로그 전체 게시글 수
+type BlogPostPage struct {
+	Posts        []BlogPost
+	TotalCount   int // 블로그 전체 게시글 수
+	CountPerPage int // 실제 적용된 페이지당 게시글 수
+}
+
+// 목록 API가 허용하는 페이지당 게시글 수
+var blogPageSizes = []int{5, 10, 15, 20, 30}
+
+const defaultBlogPageSize = 30
+
+// 요청한 크기 이상인 가장 작은 허용 크기 (최대 30)
+func normalizeBlogPageSize(size int) int {
+	for _, allowed := range blogPageSizes {
+		if size <= allowed {
+			return allowed
+		}
+	}
+	return blogPageSizes[len(blogPageSizes)-1]
+}
+
+// 전체 게시글 수로 페이지 수 계산
+func blogPageCount(totalCount, countPerPage int) int {
+	if totalCount <= 0 || countPerPage <= 0 {
+		return 0
+	}
+	return (totalCount + countPerPage - 1) / countPerPage
+}
+
 // 게시글 목록 가져오기 - 개선된 버전
 func GetBlogPostList(blogID string, page int) ([]BlogPost, error) {
-	url := fmt.Sprintf("https://blog.naver.com/PostTitleListAsync.naver?blogId=%s&viewdate=&currentPage=%d&categoryNo=0&parentCategoryNo=0&countPerPage=5", blogID, page)
+	result, err := GetBlogPostPage(blogID, page, 5)
+	return result.Posts, err
+}
+
+// GetBlogPostPage 페이지 크기를 지정해 게시글 목록과 전체 게시글 수 가져오기
+func GetBlogPostPage(blogID string, page, countPerPage int) (BlogPostPage, error) {
+	countPerPage = normalizeBlogPageSize(countPerPage)
+	url := fmt.Sprintf("%s/PostTitleListAsync.naver?blogId=%s&viewdate=&currentPage=%d&categoryNo=0&parentCategoryNo=0&countPerPage=%d", endpoints.Blog, blogID, page, countPerPage)
 
+	blogLimiter.Wait()
 	resp, err := client.Get(url)
 	if err != nil {
-		return nil, fmt.Errorf("게시글 목록 요청 실패: %v", err)
+		return BlogPostPage{}, fmt.Errorf("게시글 목록 요청 실패: %v", err)
 	}
 	defer resp.Body.Close()
 
 	// 응답 본문 읽기
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("응답 읽기 실패: %v", err)
+		return BlogPostPage{}, fmt.Errorf("응답 읽기 실패: %v", err)
 	}
 
-	// 작은따옴표를 큰따옴표로 변환
-	jsonStr := strings.ReplaceAll(string(body), "'", "\"")
-
-	var blogResponse NaverBlogResponse
-	if err := json.Unmarshal([]byte(jsonStr), &blogResponse); err != nil {
-		return nil, fmt.Errorf("JSON 파싱 실패: %v", err)
+	blogResponse, err := parseBlogTitleList(body)
+	if err != nil {
+		return BlogPostPage{}, fmt.Errorf("목록 응답 파싱 실패: %v", err)
 	}
 
 	if blogResponse.ResultCode != "S" {
-		return nil, fmt.Errorf("API 응답 오류: %s", blogResponse.ResultMessage)
+		return BlogPostPage{}, fmt.Errorf("API 응답 오류: %s", blogResponse.ResultMessage)
+	}
+
+	result := BlogPostPage{CountPerPage: countPerPage}
+	result.TotalCount, _ = strconv.Atoi(blogResponse.TotalCount)
+	if n, err := strconv.Atoi(blogResponse.CountPerPage); err == nil && n > 0 {
+		result.CountPerPage = n
 	}
 
-	var posts []BlogPost
 	for _, post := range blogResponse.PostList {
-		posts = append(posts, BlogPost{
-			ID:          post.LogNo,
-			Title:       post.Title,
-			WriteDate:   post.AddDate,
-			OriginalURL: fmt.Sprintf("https://blog.naver.com/%s/%s", blogID, post.LogNo),
+		readCount, _ := strconv.Atoi(post.ReadCount)
+		commentCount, _ := strconv.Atoi(post.CommentCount)
+		categoryNo, _ := strconv.Atoi(post.CategoryNo)
+		result.Posts = append(result.Posts, BlogPost{
+			ID:           post.LogNo,
+			Title:        post.Title,
+			WriteDate:    post.AddDate,
+			OriginalURL:  blogPostURL(blogID, post.LogNo),
+			ReadCount:    readCount,
+			CommentCount: commentCount,
+			CategoryNo:   categoryNo,
 		})
 	}
 
-	if len(posts) == 0 {
+	if len(result.Posts) == 0 {
 		log.Printf("⚠️ 게시글을 찾을 수 없습니다. URL: %s", url)
 	}
 
-	return posts, nil
+	return result, nil
 }
 
 // 게시글 상세 정보 가져오기 - 개선된 버전
 func GetBlogPostDetail(blogID string, articleID string) (BlogPost, error) {
-	url := fmt.Sprintf("https://blog.naver.com/PostView.naver?blogId=%s&logNo=%s", blogID, articleID)
+	url := fmt.Sprintf("%s/PostView.naver?blogId=%s&logNo=%s", endpoints.Blog, blogID, articleID)
 
+	blogLimiter.Wait()
 	resp, err := client.Get(url)
 	if err != nil {
 		return BlogPost{}, fmt.Errorf("게시글 상세 로드 실패: %v", err)
 	}
 	defer resp.Body.Close()
 
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	return parseBlogPostHTML(resp.Body, blogID, articleID)
+}
+
+// 게시글 본문 HTML에서 게시글 정보 추출 (데스크톱/모바일 PostView 공통)
+func parseBlogPostHTML(r io.Reader, blogID, articleID string) (BlogPost, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
 	if err != nil {
 		return BlogPost{}, fmt.Errorf("HTML 파싱 실패: %v", err)
 	}
@@ -128,27 +214,47 @@ func GetBlogPostDetail(blogID string, articleID string) (BlogPost, error) {
 	// title 태그에서 제목 추출
 	title := doc.Find("title").Text()
 	// 네이버 블로그 제목에서 불필요한 부분 제거 (예: " : 네이버 블로그")
-	title = strings.Split(title, " : 네이버 블로그")[0]
+	title = utils.CleanText(strings.Split(title, " : 네이버 블로그")[0])
 
 	// .se-main-container 내의 콘텐츠만 추출
 	content := doc.Find(".se-main-container").Text()
 
-	// 연속된 공백과 줄바꿈 정리
-	content = strings.Join(strings.Fields(content), " ")
+	// NFC 정규화, 보이지 않는 문자 제거, 연속된 공백과 줄바꿈 정리
+	content = utils.CleanText(content)
 
-	// 불필요한 공백 제거
-	content = strings.TrimSpace(content)
+	var paragraphs []string
+	doc.Find(".se-main-container .se-text-paragraph").Each(func(i int, s *goquery.Selection) {
+		if p := utils.CleanText(s.Text()); p != "" {
+			paragraphs = append(paragraphs, p)
+		}
+	})
+
+	// 비공개/이웃공개/성인인증/삭제 게시글 확인
+	status, reason := detectBlogPostStatus(doc, title, content)
+	if status != BlogPostOK {
+		return BlogPost{ID: articleID, OriginalURL: blogPostURL(blogID, articleID), Status: status},
+			fmt.Errorf("%w: %s (%s)", ErrBlogPostInaccessible, status, reason)
+	}
+
+	thumbnail, _ := doc.Find(`meta[property="og:image"]`).Attr("content")
 
 	blogPost := BlogPost{
-		ID:          articleID,
-		OriginalURL: url,
-		Title:       title,
-		Writer:      utils.FindFirstMatch(doc, writerSelectors),
-		WriteDate:   utils.FindFirstMatch(doc, dateSelectors),
-		Content:     content,
-		Comments:    extractComments(doc),
+		ID:           articleID,
+		OriginalURL:  blogPostURL(blogID, articleID),
+		Title:        title,
+		Writer:       utils.FindFirstMatch(doc, writerSelectors),
+		WriteDate:    utils.FindFirstMatch(doc, dateSelectors),
+		Content:      content,
+		Paragraphs:   paragraphs,
+		Comments:     extractComments(doc),
+		Status:       status,
+		Tags:         extractTags(doc),
+		CategoryName: utils.FindFirstMatch(doc, categorySelectors),
+		Thumbnail:    thumbnail,
 	}
 
+	blogPost.Sponsored, blogPost.SponsorEvidence = detectSponsored(doc, content)
+
 	if blogPost.Title == "" && blogPost.Content == "" {
 		return blogPost, fmt.Errorf("게시글 정보를 추출할 수 없습니다")
 	}
@@ -156,53 +262,191 @@ func GetBlogPostDetail(blogID string, articleID string) (BlogPost, error) {
 	return blogPost, nil
 }
 
+// BlogCrawlOptions 블로그 크롤링 설정
+type BlogCrawlOptions struct {
+	MaxPages    int // 최대 목록 페이지 수 (0은 블로그 전체)
+	PageSize    int // 페이지당 게시글 수 (데스크톱은 5, 10, 15, 20, 30 중 하나로 맞춤, 기본값: 30)
+	Concurrency int // 동시에 진행할 요청 수 (기본값: 4)
+
+	OutputDir string // 결과 파일 디렉토리 (기본값: output_blog)
+
+	Backend BlogBackend // 목록/본문을 가져오는 방식 (기본값: DesktopBlogBackend)
+
+	// 블로그 정보(GetBlogProfile)를 함께 저장 (모바일 API 요청이 더 들기 때문에 기본값은 false)
+	Profile bool
+
+	// 중복 인덱스 (nil이면 중복 확인 안 함)
+	// 이전 실행에서 수집한 게시글은 결과에서 빼고, 유사 중복 게시글은 클러스터 ID로 묶음
+	Dedup *dedup.Index
+
+	// 개인정보 가리기 (nil이면 원문 그대로 저장)
+	Redact *redact.Redactor
+
+	// 분석용 본문 처리 (nil이면 처리 안 함)
+	// 문장/토큰/이모지를 TextFeatures에 채우고, StripEmoji면 본문에서 이모지를 지움
+	Text *textproc.Options
+
+	// 임베딩용 청크 (nil이면 저장 안 함)
+	// 지정하면 전체 결과와 함께 blog_{블로그ID}_chunks_{시각}.jsonl 저장
+	Chunks *chunk.Options
+
+	// 페이지 처리가 끝날 때마다 호출 (진행 상황/중간 결과 전달용, 여러 고루틴에서 동시에 호출될 수 있음)
+	OnPage func(page, totalPages int, posts []BlogPost)
+}
+
+func (o BlogCrawlOptions) stages() postStages {
+	return postStages{index: o.Dedup, redactor: o.Redact, text: o.Text}
+}
+
+const defaultBlogConcurrency = 4
+
 // CrawlBlog performs the main crawling operation for a Naver blog
-func CrawlBlog(blogID string, maxPages int) ([]BlogPost, error) {
-	log.Printf("🚀 네이버 블로그 '%s' 크롤링 시작...", blogID)
+func CrawlBlog(blogID string, opts BlogCrawlOptions) ([]BlogPost, error) {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultBlogConcurrency
+	}
+	if opts.Backend == nil {
+		opts.Backend = DesktopBlogBackend
+	}
+	log.Printf("🚀 네이버 블로그 '%s' 크롤링 시작... (동시 요청 %d개, %s 백엔드)", blogID, opts.Concurrency, opts.Backend.Name())
 
-	outputDir := "output_blog"
+	outputDir := opts.OutputDir
+	if outputDir == "" {
+		outputDir = "output_blog"
+	}
 	if err := os.MkdirAll(outputDir, 0755); err != nil {
 		return nil, fmt.Errorf("출력 디렉토리 생성 실패: %v", err)
 	}
 
-	var allPosts []BlogPost
-	var mu sync.Mutex
-
-	for page := 1; page <= maxPages; page++ {
-		detailedPostsOnPage, err := processPage(blogID, page, maxPages)
-		if err != nil {
-			log.Printf("⚠️ 페이지 %d 처리 실패: %v", page, err)
-			continue
+	// 어떤 블로그에서 수집한 데이터인지 알 수 있도록 블로그 정보를 함께 저장
+	if opts.Profile {
+		if profile, err := GetBlogProfile(blogID); err != nil {
+			log.Printf("⚠️ 블로그 정보 가져오기 실패: %v", err)
+		} else if _, err := SaveBlogProfile(profile, outputDir); err != nil {
+			log.Printf("⚠️ %v", err)
 		}
+	}
 
-		if len(detailedPostsOnPage) == 0 {
-			continue
-		}
+	if opts.PageSize <= 0 {
+		opts.PageSize = defaultBlogPageSize
+	}
 
-		mu.Lock()
-		allPosts = append(allPosts, detailedPostsOnPage...)
-		mu.Unlock()
+	// 첫 페이지의 전체 게시글 수로 실제 마지막 페이지 계산
+	first, err := opts.Backend.PostPage(blogID, 1, opts.PageSize)
+	if err != nil {
+		return nil, fmt.Errorf("첫 페이지 로드 실패: %v", err)
+	}
+	lastPage := blogPageCount(first.TotalCount, first.CountPerPage)
+	if lastPage == 0 && len(first.Posts) > 0 {
+		// 전체 게시글 수를 알 수 없으면 지정한 페이지 수만큼 시도
+		log.Printf("⚠️ 전체 게시글 수를 알 수 없습니다. 최대 %d 페이지까지 시도합니다.", max(opts.MaxPages, 1))
+		lastPage = max(opts.MaxPages, 1)
+	}
+	pagesToCrawl := lastPage
+	if opts.MaxPages > 0 && opts.MaxPages < lastPage {
+		pagesToCrawl = opts.MaxPages
+	}
+	log.Printf("📚 전체 %d개 게시글, %d 페이지 중 %d 페이지 크롤링 (페이지당 %d개)",
+		first.TotalCount, lastPage, pagesToCrawl, first.CountPerPage)
 
-		if err := savePageResults(blogID, page, detailedPostsOnPage, outputDir); err != nil {
-			log.Printf("⚠️ 페이지 %d 결과 저장 실패: %v", page, err)
-		}
+	var allPosts []BlogPost
+	var skippedPosts []BlogPost
+	var mu sync.Mutex
+
+	// 목록/상세 요청이 함께 쓰는 작업자 슬롯 (요청 간격은 blogLimiter가 별도로 제한)
+	workers := make(chan struct{}, opts.Concurrency)
+
+	var stop pageStop
+	var eg errgroup.Group
+	eg.SetLimit(opts.Concurrency)
+	for page := 1; page <= pagesToCrawl && !stop.after(page); page++ {
+		page := page
+		eg.Go(func() error {
+			if stop.after(page) {
+				return nil
+			}
+			var postsOnPage []BlogPost
+			if page == 1 {
+				postsOnPage = first.Posts
+			}
+			detailedPostsOnPage, skippedOnPage, err := processPage(opts.Backend, blogID, page, pagesToCrawl, opts.PageSize, postsOnPage, opts.Dedup, workers)
+			if errors.Is(err, errPageCollected) {
+				stop.set(page)
+				if opts.OnPage != nil {
+					opts.OnPage(page, pagesToCrawl, nil)
+				}
+				return nil
+			}
+			if err != nil {
+				log.Printf("⚠️ 페이지 %d 처리 실패: %v", page, err)
+				if opts.OnPage != nil {
+					opts.OnPage(page, pagesToCrawl, nil)
+				}
+				return nil
+			}
+
+			indexGoneBlogPosts(opts.Dedup, blogID, skippedOnPage)
+			detailedPostsOnPage = opts.stages().blog(blogID, detailedPostsOnPage)
+
+			mu.Lock()
+			skippedPosts = append(skippedPosts, skippedOnPage...)
+			allPosts = append(allPosts, detailedPostsOnPage...)
+			mu.Unlock()
+
+			if opts.OnPage != nil {
+				opts.OnPage(page, pagesToCrawl, detailedPostsOnPage)
+			}
+
+			if len(detailedPostsOnPage) == 0 {
+				return nil
+			}
+			if err := savePageResults(blogID, page, detailedPostsOnPage, outputDir); err != nil {
+				log.Printf("⚠️ 페이지 %d 결과 저장 실패: %v", page, err)
+			}
+			return nil
+		})
 	}
+	eg.Wait()
+
+	// 페이지가 끝나는 순서와 관계없이 최신 글부터 정렬
+	sortPostsByLogNo(allPosts)
 
 	if len(allPosts) > 0 {
 		if err := saveFullResults(blogID, allPosts, outputDir); err != nil {
 			log.Printf("⚠️ 전체 결과 저장 실패: %v", err)
 		}
+		if opts.Chunks != nil {
+			if err := saveBlogChunks(blogID, allPosts, *opts.Chunks, outputDir); err != nil {
+				log.Printf("⚠️ %v", err)
+			}
+		}
 		printResults(allPosts)
 	} else {
 		fmt.Println("⚠️ 수집된 게시글이 없습니다. 블로그 ID를 확인해주세요.")
 	}
 
 	log.Printf("🎉 네이버 블로그 '%s' 크롤링 완료! 총 %d개 게시글 수집", blogID, len(allPosts))
+	logBlogStatusSummary(skippedPosts)
+	logSponsoredSummary(allPosts)
 	return allPosts, nil
 }
 
 // Helper functions
 
+// 해시태그 추출 (앞의 #은 빼고 중복 제거)
+func extractTags(doc *goquery.Document) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	doc.Find(tagSelectors).Each(func(i int, s *goquery.Selection) {
+		tag := strings.TrimPrefix(utils.CleanText(s.Text()), "#")
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	})
+	return tags
+}
+
 func extractComments(doc *goquery.Document) []BlogComment {
 	var comments []BlogComment
 	doc.Find(commentSelectors).Each(func(i int, s *goquery.Selection) {
@@ -213,40 +457,121 @@ func extractComments(doc *goquery.Document) []BlogComment {
 				Content:   content,
 				Writer:    utils.CleanText(s.Find(commentWriterSelectors).First().Text()),
 				WriteDate: utils.CleanText(s.Find(commentDateSelectors).First().Text()),
+
+				WriterBlogID: findBlogID(s),
 			})
 		}
 	})
 	return comments
 }
 
-func processPage(blogID string, page, maxPages int) ([]BlogPost, error) {
-	log.Printf("🔄 %d/%d 페이지 처리 중...", page, maxPages)
+// 페이지의 게시글 상세 정보 수집 (접근할 수 없는 게시글은 따로 반환)
+// postsOnPage가 비어있으면 목록을 먼저 가져옴
+// 각 요청은 workers 슬롯을 하나씩 차지하므로 전체 동시 요청 수는 슬롯 수를 넘지 않음
+func processPage(backend BlogBackend, blogID string, page, lastPage, pageSize int, postsOnPage []BlogPost, known *dedup.Index, workers chan struct{}) ([]BlogPost, []BlogPost, error) {
+	log.Printf("🔄 %d/%d 페이지 처리 중...", page, lastPage)
 
-	postsOnPage, err := GetBlogPostList(blogID, page)
-	if err != nil {
-		return nil, fmt.Errorf("게시글 목록 가져오기 실패: %v", err)
+	if postsOnPage == nil {
+		workers <- struct{}{}
+		result, err := backend.PostPage(blogID, page, pageSize)
+		<-workers
+		if err != nil {
+			return nil, nil, fmt.Errorf("게시글 목록 가져오기 실패: %v", err)
+		}
+		postsOnPage = result.Posts
 	}
 
 	if len(postsOnPage) == 0 {
-		return nil, fmt.Errorf("게시글을 찾을 수 없습니다")
+		return nil, nil, fmt.Errorf("게시글을 찾을 수 없습니다")
 	}
 
-	var detailedPostsOnPage []BlogPost
+	// 이전 실행에서 수집한 게시글은 상세 정보를 다시 요청하지 않음
+	postsOnPage = skipKnownBlogPosts(known, blogID, postsOnPage)
+	if len(postsOnPage) == 0 {
+		return nil, nil, errPageCollected
+	}
+
+	details := make([]BlogPost, len(postsOnPage))
+	errs := make([]error, len(postsOnPage))
+	var wg sync.WaitGroup
 	for i, post := range postsOnPage {
-		log.Printf("  📖 %d페이지 게시글 %d/%d 상세 정보 처리 중... (ID: %s)", page, i+1, len(postsOnPage), post.ID)
+		wg.Add(1)
+		go func(i int, post BlogPost) {
+			defer wg.Done()
+			workers <- struct{}{}
+			defer func() { <-workers }()
+
+			log.Printf("  📖 %d페이지 게시글 %d/%d 상세 정보 처리 중... (ID: %s)", page, i+1, len(postsOnPage), post.ID)
+			details[i], errs[i] = backend.PostDetail(blogID, post.ID)
+		}(i, post)
+	}
+	wg.Wait()
 
-		detail, err := GetBlogPostDetail(blogID, post.ID)
-		if err != nil {
-			log.Printf("⚠️ 게시글 %s 상세 정보 가져오기 실패: %v", post.ID, err)
+	var detailedPostsOnPage []BlogPost
+	var skipped []BlogPost
+	for i, detail := range details {
+		id := postsOnPage[i].ID
+		if errors.Is(errs[i], ErrBlogPostInaccessible) {
+			log.Printf("🚫 게시글 %s 건너뜀: %v", id, errs[i])
+			skipped = append(skipped, detail)
+			continue
+		}
+		if errs[i] != nil {
+			log.Printf("⚠️ 게시글 %s 상세 정보 가져오기 실패: %v", id, errs[i])
 			continue
 		}
 
+		mergeListInfo(&detail, postsOnPage[i])
+
 		if detail.Title != "" || detail.Content != "" {
 			detailedPostsOnPage = append(detailedPostsOnPage, detail)
 		}
 	}
 
-	return detailedPostsOnPage, nil
+	// 공감 수는 본문에 없으므로 페이지 단위로 한 번에 조회
+	workers <- struct{}{}
+	err := fillBlogLikeCounts(blogID, detailedPostsOnPage)
+	<-workers
+	if err != nil {
+		log.Printf("⚠️ %d페이지 공감 수 가져오기 실패: %v", page, err)
+	}
+
+	return detailedPostsOnPage, skipped, nil
+}
+
+// 본문에서 얻을 수 없는 정보는 목록 정보로 채움
+func mergeListInfo(detail *BlogPost, listed BlogPost) {
+	if detail.Title == "" {
+		detail.Title = listed.Title
+	}
+	if detail.WriteDate == "" {
+		detail.WriteDate = listed.WriteDate
+	}
+	detail.ReadCount = listed.ReadCount
+	detail.CommentCount = listed.CommentCount
+	if detail.CommentCount == 0 {
+		detail.CommentCount = len(detail.Comments)
+	}
+	detail.LikeCount = listed.LikeCount
+	detail.CategoryNo = listed.CategoryNo
+	if detail.CategoryName == "" {
+		detail.CategoryName = listed.CategoryName
+	}
+	if detail.Thumbnail == "" {
+		detail.Thumbnail = listed.Thumbnail
+	}
+}
+
+// logNo 내림차순(최신 글 먼저) 정렬
+func sortPostsByLogNo(posts []BlogPost) {
+	sort.SliceStable(posts, func(i, j int) bool {
+		a, errA := strconv.ParseInt(posts[i].ID, 10, 64)
+		b, errB := strconv.ParseInt(posts[j].ID, 10, 64)
+		if errA != nil || errB != nil {
+			return posts[i].ID > posts[j].ID
+		}
+		return a > b
+	})
 }
 
 func savePageResults(blogID string, page int, posts []BlogPost, outputDir string) error {
@@ -276,7 +601,7 @@ func saveFullResults(blogID string, posts []BlogPost, outputDir string) error {
 func formatPosts(posts []BlogPost) []map[string]interface{} {
 	var formattedPosts []map[string]interface{}
 	for _, post := range posts {
-		formattedPosts = append(formattedPosts, map[string]interface{}{
+		formatted := map[string]interface{}{
 			"title":   post.Title,
 			"content": post.Content,
 			"metadata": map[string]interface{}{
@@ -284,9 +609,26 @@ func formatPosts(posts []BlogPost) []map[string]interface{} {
 				"writer":     post.Writer,
 				"write_date": post.WriteDate,
 				"url":        post.OriginalURL,
+				"status":     post.Status,
+				"category":   post.CategoryName,
+				"tags":       post.Tags,
+				"thumbnail":  post.Thumbnail,
+
+				"duplicate_cluster": post.DuplicateCluster,
+				"sponsored":         post.Sponsored,
+				"sponsor_evidence":  post.SponsorEvidence,
+			},
+			"engagement": map[string]interface{}{
+				"read_count":    post.ReadCount,
+				"comment_count": post.CommentCount,
+				"like_count":    post.LikeCount,
 			},
 			"comments": post.Comments,
-		})
+		}
+		if post.TextFeatures != nil {
+			formatted["text_features"] = post.TextFeatures
+		}
+		formattedPosts = append(formattedPosts, formatted)
 	}
 	return formattedPosts
 }
@@ -299,7 +641,7 @@ func printResults(posts []BlogPost) {
 			break
 		}
 		fmt.Printf("📌 [%d] %s\n", i+1, post.Title)
-		fmt.Printf("   👤 %s | 📅 %s | 💬 %d개 댓글\n", post.Writer, post.WriteDate, len(post.Comments))
+		fmt.Printf("   👤 %s | 📅 %s | 👀 %d | 💬 %d | ❤️ %d\n", post.Writer, post.WriteDate, post.ReadCount, post.CommentCount, post.LikeCount)
 		fmt.Printf("   📝 %s...\n", utils.TruncateString(post.Content, 100))
 		fmt.Println()
 	}
@@ -0,0 +1,161 @@
+package crawling
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"log"
+	"net/http"
+	"time"
+)
+
+// BlogBackend 블로그 목록과 본문을 가져오는 방식
+// 어떤 방식을 쓰든 같은 BlogPost를 돌려주므로 결과를 쓰는 쪽은 구분할 필요가 없음
+type BlogBackend interface {
+	Name() string
+	// 목록 한 페이지와 블로그 전체 게시글 수
+	PostPage(blogID string, page, countPerPage int) (BlogPostPage, error)
+	// 게시글 본문 (볼 수 없는 게시글은 ErrBlogPostInaccessible)
+	PostDetail(blogID, logNo string) (BlogPost, error)
+}
+
+var (
+	// DesktopBlogBackend 데스크톱 PostTitleListAsync와 PostView HTML 사용 (기본값)
+	DesktopBlogBackend BlogBackend = desktopBlogBackend{}
+	// MobileBlogBackend 목록은 m.blog.naver.com JSON API, 본문은 모바일 PostView HTML 사용
+	MobileBlogBackend BlogBackend = mobileBlogBackend{}
+)
+
+// BlogBackendByName 이름으로 백엔드 선택 ("desktop", "mobile", 빈 문자열은 desktop)
+func BlogBackendByName(name string) (BlogBackend, error) {
+	switch name {
+	case "", "desktop":
+		return DesktopBlogBackend, nil
+	case "mobile":
+		return MobileBlogBackend, nil
+	}
+	return nil, fmt.Errorf("알 수 없는 블로그 백엔드: %s (desktop, mobile 중 선택)", name)
+}
+
+type desktopBlogBackend struct{}
+
+func (desktopBlogBackend) Name() string { return "desktop" }
+
+func (desktopBlogBackend) PostPage(blogID string, page, countPerPage int) (BlogPostPage, error) {
+	return GetBlogPostPage(blogID, page, countPerPage)
+}
+
+func (desktopBlogBackend) PostDetail(blogID, logNo string) (BlogPost, error) {
+	return GetBlogPostDetail(blogID, logNo)
+}
+
+// 모바일 블로그 게시글 목록 API 응답
+type mobilePostListResponse struct {
+	IsSuccess bool `json:"isSuccess"`
+	Result    struct {
+		Items []struct {
+			LogNo        json.Number `json:"logNo"`
+			Title        string      `json:"titleWithInspectMessage"`
+			AddDate      int64       `json:"addDate"` // 밀리초 단위 유닉스 시간
+			CategoryNo   int         `json:"categoryNo"`
+			CategoryName string      `json:"categoryName"`
+			CommentCnt   int         `json:"commentCnt"`
+			SympathyCnt  int         `json:"sympathyCnt"`
+			ReadCnt      int         `json:"readCnt"`
+			ThumbnailURL string      `json:"thumbnailUrl"`
+		} `json:"items"`
+		TotalCount int `json:"totalCount"`
+	} `json:"result"`
+}
+
+// 데스크톱 본문과 같은 형식의 작성일 (예: 2024. 4. 25. 21:03)
+var kst = time.FixedZone("KST", 9*60*60)
+
+func formatBlogDate(millis int64) string {
+	if millis <= 0 {
+		return ""
+	}
+	return time.UnixMilli(millis).In(kst).Format("2006. 1. 2. 15:04")
+}
+
+type mobileBlogBackend struct{}
+
+func (mobileBlogBackend) Name() string { return "mobile" }
+
+// 모바일 API는 블로그 주소를 Referer로 보내야 응답함
+func (mobileBlogBackend) get(blogID, url string) (*http.Response, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Referer", fmt.Sprintf("%s/%s", endpoints.MobileBlog, blogID))
+
+	blogLimiter.Wait()
+	return client.Do(req)
+}
+
+func (b mobileBlogBackend) PostPage(blogID string, page, countPerPage int) (BlogPostPage, error) {
+	url := fmt.Sprintf("%s/api/blogs/%s/post-list?categoryNo=0&itemCount=%d&page=%d", endpoints.MobileBlog, blogID, countPerPage, page)
+
+	resp, err := b.get(blogID, url)
+	if err != nil {
+		return BlogPostPage{}, fmt.Errorf("게시글 목록 요청 실패: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return BlogPostPage{}, fmt.Errorf("게시글 목록 요청 실패: HTTP %d", resp.StatusCode)
+	}
+
+	var listResponse mobilePostListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&listResponse); err != nil {
+		return BlogPostPage{}, fmt.Errorf("JSON 파싱 실패: %v", err)
+	}
+	if !listResponse.IsSuccess {
+		return BlogPostPage{}, fmt.Errorf("API 응답 오류: 존재하지 않거나 볼 수 없는 블로그입니다")
+	}
+
+	result := BlogPostPage{
+		TotalCount:   listResponse.Result.TotalCount,
+		CountPerPage: countPerPage,
+	}
+	for _, item := range listResponse.Result.Items {
+		logNo := item.LogNo.String()
+		result.Posts = append(result.Posts, BlogPost{
+			ID:          logNo,
+			Title:       html.UnescapeString(item.Title), // 데스크톱 목록과 달리 URL 인코딩되지 않음
+			WriteDate:   formatBlogDate(item.AddDate),
+			OriginalURL: blogPostURL(blogID, logNo),
+
+			ReadCount:    item.ReadCnt,
+			CommentCount: item.CommentCnt,
+			LikeCount:    item.SympathyCnt,
+			CategoryNo:   item.CategoryNo,
+			CategoryName: item.CategoryName,
+			Thumbnail:    item.ThumbnailURL,
+		})
+	}
+
+	if len(result.Posts) == 0 {
+		log.Printf("⚠️ 게시글을 찾을 수 없습니다. URL: %s", url)
+	}
+	return result, nil
+}
+
+// 모바일 JSON API에는 본문 API가 따로 없어 모바일 PostView HTML을 데스크톱과 같은 방식으로 파싱
+func (b mobileBlogBackend) PostDetail(blogID, logNo string) (BlogPost, error) {
+	url := fmt.Sprintf("%s/PostView.naver?blogId=%s&logNo=%s", endpoints.MobileBlog, blogID, logNo)
+
+	resp, err := b.get(blogID, url)
+	if err != nil {
+		return BlogPost{}, fmt.Errorf("게시글 상세 로드 실패: %v", err)
+	}
+	defer resp.Body.Close()
+
+	// 오류 페이지를 본문으로 파싱하지 않도록 (비공개/삭제 안내는 200으로 옴)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return BlogPost{}, fmt.Errorf("게시글 상세 로드 실패: HTTP %d", resp.StatusCode)
+	}
+
+	return parseBlogPostHTML(resp.Body, blogID, logNo)
+}
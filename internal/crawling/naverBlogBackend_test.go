@@ -0,0 +1,119 @@
+package crawling
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+const testMobileListPath = "/m/api/blogs/allminwon/post-list"
+
+func TestMobileBlogBackendPostPage(t *testing.T) {
+	fake := newFakeNaver(t)
+	fake.route(testMobileListPath, map[string]string{"page": "1", "itemCount": "3"}, http.StatusOK, "mobile_post_list_page1.json")
+	fake.route("/m/api/blogs/nobody/post-list", nil, http.StatusOK, "mobile_post_list_error.json")
+
+	result, err := MobileBlogBackend.PostPage("allminwon", 1, 3)
+	if err != nil {
+		t.Fatalf("PostPage: %v", err)
+	}
+	if result.TotalCount != 5 || result.CountPerPage != 3 || len(result.Posts) != 3 {
+		t.Fatalf("unexpected page: %+v", result)
+	}
+
+	first := result.Posts[0]
+	if first.ID != "223428124420" || first.Title != "제주 여행 후기" || first.WriteDate != "2024. 4. 25. 21:03" {
+		t.Errorf("first post = %+v", first)
+	}
+	if first.ReadCount != 120 || first.CommentCount != 3 || first.LikeCount != 9 || first.CategoryName != "국내 여행" || first.Thumbnail == "" {
+		t.Errorf("engagement of first post = %+v", first)
+	}
+	if first.OriginalURL != "https://blog.naver.com/allminwon/223428124420" {
+		t.Errorf("OriginalURL = %q", first.OriginalURL)
+	}
+	if title := result.Posts[2].Title; title != `"가을" 캠핑 & C++` {
+		t.Errorf("title = %q", title)
+	}
+
+	fake.mu.Lock()
+	referer := fake.requests[0].Header.Get("Referer")
+	fake.mu.Unlock()
+	if referer != fake.URL+"/m/allminwon" {
+		t.Errorf("Referer = %q", referer)
+	}
+
+	if _, err := MobileBlogBackend.PostPage("nobody", 1, 3); err == nil {
+		t.Error("expected error for unsuccessful response")
+	}
+}
+
+func TestMobileBlogBackendPostDetail(t *testing.T) {
+	fake := newFakeNaver(t)
+	fake.route("/m/PostView.naver", map[string]string{"logNo": "1"}, http.StatusOK, "blog_post_ok.html")
+	fake.route("/m/PostView.naver", map[string]string{"logNo": "2"}, http.StatusOK, "blog_post_private.html")
+	fake.route("/m/PostView.naver", map[string]string{"logNo": "3"}, http.StatusServiceUnavailable, "blog_post_ok.html")
+
+	post, err := MobileBlogBackend.PostDetail("allminwon", "1")
+	if err != nil {
+		t.Fatalf("PostDetail: %v", err)
+	}
+	if post.Title != "제주 여행 후기" || post.Status != BlogPostOK || post.OriginalURL != "https://blog.naver.com/allminwon/1" {
+		t.Errorf("post = %+v", post)
+	}
+
+	post, err = MobileBlogBackend.PostDetail("allminwon", "2")
+	if !errors.Is(err, ErrBlogPostInaccessible) || post.Status != BlogPostPrivate {
+		t.Errorf("private post: status %q, err %v", post.Status, err)
+	}
+
+	// 오류 응답은 본문으로 파싱하지 않음
+	post, err = MobileBlogBackend.PostDetail("allminwon", "3")
+	if err == nil || errors.Is(err, ErrBlogPostInaccessible) || post.Title != "" {
+		t.Errorf("HTTP 503: post %+v, err %v", post, err)
+	}
+}
+
+func TestCrawlBlogMobileBackend(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	fake := newFakeNaver(t)
+	fake.route(testMobileListPath, map[string]string{"page": "1"}, http.StatusOK, "mobile_post_list_page1.json")
+	fake.route(testMobileListPath, map[string]string{"page": "2"}, http.StatusOK, "mobile_post_list_page2.json")
+	fake.route("/m/PostView.naver", map[string]string{"logNo": "223202197008"}, http.StatusOK, "blog_post_private.html")
+	fake.route("/m/PostView.naver", map[string]string{"logNo": "223003019400"}, http.StatusOK, "blog_post_neighbor.html")
+	fake.route("/m/PostView.naver", nil, http.StatusOK, "blog_post_ok.html")
+
+	posts, err := CrawlBlog("allminwon", BlogCrawlOptions{PageSize: 3, Backend: MobileBlogBackend})
+	if err != nil {
+		t.Fatalf("CrawlBlog: %v", err)
+	}
+
+	// 데스크톱 백엔드와 같은 게시글을 같은 순서로 수집
+	wantIDs := []string{"223428124420", "223009170287", "222996100708"}
+	if len(posts) != len(wantIDs) {
+		t.Fatalf("got %d posts, want %d", len(posts), len(wantIDs))
+	}
+	for i, id := range wantIDs {
+		if posts[i].ID != id {
+			t.Errorf("posts[%d].ID = %q, want %q", i, posts[i].ID, id)
+		}
+	}
+	// 목록에서 받은 공감 수는 유지하고, 썸네일은 본문의 대표 이미지 사용
+	if posts[0].LikeCount != 9 || posts[0].Thumbnail != "https://blogthumb.pstatic.net/MjAyNDA0MjVfMTAw/sample.jpg?type=w2" {
+		t.Errorf("first post = %+v", posts[0])
+	}
+	if n := fake.count("/PostView.naver") + fake.count("/PostTitleListAsync.naver"); n != 0 {
+		t.Errorf("mobile backend made %d desktop requests", n)
+	}
+}
+
+func TestBlogBackendByName(t *testing.T) {
+	for name, want := range map[string]BlogBackend{"": DesktopBlogBackend, "desktop": DesktopBlogBackend, "mobile": MobileBlogBackend} {
+		if got, err := BlogBackendByName(name); err != nil || got != want {
+			t.Errorf("BlogBackendByName(%q) = %v, %v", name, got, err)
+		}
+	}
+	if _, err := BlogBackendByName("app"); err == nil {
+		t.Error("expected error for unknown backend")
+	}
+}
@@ -0,0 +1,333 @@
+package crawling
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log"
+	"naverCrawler/internal/utils"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// 블로그 사이의 관계 종류
+const (
+	RelationNeighbor = "neighbor" // From의 공개 이웃 목록에 To가 있음
+	RelationComment  = "comment"  // From 블로그 주인이 To의 게시글에 댓글을 남김 (가중치는 댓글 수)
+)
+
+// GraphEdge 블로그 → 블로그 관계
+type GraphEdge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Relation string `json:"relation"`
+	Weight   int    `json:"weight"`
+}
+
+// GraphNode 방문한 블로그와 시작 블로그로부터의 거리
+type GraphNode struct {
+	BlogID    string `json:"blog_id"`
+	Depth     int    `json:"depth"`
+	Expanded  bool   `json:"expanded"` // 이웃/댓글 관계를 확인했는지 여부
+	PostCount int    `json:"post_count,omitempty"`
+}
+
+// NeighborGraph 이웃 그래프 탐색 결과
+type NeighborGraph struct {
+	Seeds []string    `json:"seeds"`
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+
+	// CrawlPosts일 때 블로그별로 수집한 게시글 (SaveNeighborGraph가 _posts.json으로 따로 저장)
+	Posts map[string][]BlogPost `json:"-"`
+}
+
+// NeighborGraphOptions 이웃 그래프 탐색 설정
+type NeighborGraphOptions struct {
+	Depth            int  // 시작 블로그에서 따라갈 최대 거리 (기본값: 1)
+	MaxBlogs         int  // 방문할 최대 블로그 수 (기본값: 50)
+	MaxNeighborPages int  // 블로그마다 확인할 이웃 목록 페이지 수 (기본값: 5)
+	FollowCommenters bool // 댓글 작성자의 블로그도 따라감
+	CommenterPosts   int  // 게시글을 수집하지 않을 때 댓글 작성자를 찾을 최근 게시글 수 (기본값: 10)
+
+	CrawlPosts  bool             // 방문한 블로그의 게시글도 CrawlBlog로 수집해 NeighborGraph.Posts에 담음
+	PostOptions BlogCrawlOptions // 게시글 수집 설정
+}
+
+func (o NeighborGraphOptions) withDefaults() NeighborGraphOptions {
+	if o.Depth <= 0 {
+		o.Depth = 1
+	}
+	if o.MaxBlogs <= 0 {
+		o.MaxBlogs = 50
+	}
+	if o.MaxNeighborPages <= 0 {
+		o.MaxNeighborPages = 5
+	}
+	if o.CommenterPosts <= 0 {
+		o.CommenterPosts = 10
+	}
+	return o
+}
+
+const neighborListSelectors = ".buddy_list a, .buddy_item a, #buddyList a, .list_buddy a"
+
+// 블로그 ID 형식 (PostView.naver 같은 페이지 경로는 제외)
+var blogIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{2,}$`)
+
+// 블로그 링크에서 블로그 ID 추출 (blog.naver.com/{id}, ?blogId={id})
+func blogIDFromURL(href string) string {
+	u, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+	if u.Host != "blog.naver.com" && u.Host != "m.blog.naver.com" {
+		return ""
+	}
+	if id := u.Query().Get("blogId"); id != "" {
+		return id
+	}
+	first, _, _ := strings.Cut(strings.TrimPrefix(u.Path, "/"), "/")
+	if blogIDPattern.MatchString(first) {
+		return first
+	}
+	return ""
+}
+
+// 선택 영역 안의 첫 블로그 링크의 ID
+func findBlogID(s *goquery.Selection) string {
+	var id string
+	s.Find("a[href]").EachWithBreak(func(i int, a *goquery.Selection) bool {
+		href, _ := a.Attr("href")
+		id = blogIDFromURL(href)
+		return id == ""
+	})
+	return id
+}
+
+// GetBlogNeighbors 공개된 이웃 목록의 블로그 ID (비공개면 빈 목록)
+func GetBlogNeighbors(blogID string, maxPages int) ([]string, error) {
+	var neighbors []string
+	seen := map[string]bool{blogID: true}
+
+	for page := 1; page <= maxPages; page++ {
+		pageURL := fmt.Sprintf("%s/BuddyList.naver?blogId=%s&currentPage=%d", endpoints.Blog, blogID, page)
+
+		blogLimiter.Wait()
+		resp, err := client.Get(pageURL)
+		if err != nil {
+			return neighbors, fmt.Errorf("이웃 목록 요청 실패: %v", err)
+		}
+		doc, err := goquery.NewDocumentFromReader(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return neighbors, fmt.Errorf("HTML 파싱 실패: %v", err)
+		}
+
+		added := 0
+		doc.Find(neighborListSelectors).Each(func(i int, a *goquery.Selection) {
+			href, _ := a.Attr("href")
+			if id := blogIDFromURL(href); id != "" && !seen[id] {
+				seen[id] = true
+				neighbors = append(neighbors, id)
+				added++
+			}
+		})
+		// 새 이웃이 없으면 마지막 페이지
+		if added == 0 {
+			break
+		}
+	}
+	return neighbors, nil
+}
+
+// 최근 게시글에서 댓글 작성자 블로그별 댓글 수
+func commenterCounts(posts []BlogPost, owner string) map[string]int {
+	counts := make(map[string]int)
+	for _, post := range posts {
+		for _, c := range post.Comments {
+			if c.WriterBlogID != "" && c.WriterBlogID != owner {
+				counts[c.WriterBlogID]++
+			}
+		}
+	}
+	return counts
+}
+
+// 게시글을 수집하지 않을 때 댓글 작성자를 찾기 위한 최근 게시글
+func recentPosts(blogID string, n int) ([]BlogPost, error) {
+	page, err := DesktopBlogBackend.PostPage(blogID, 1, n)
+	if err != nil {
+		return nil, err
+	}
+	var posts []BlogPost
+	for i, listed := range page.Posts {
+		if i >= n {
+			break
+		}
+		post, err := DesktopBlogBackend.PostDetail(blogID, listed.ID)
+		if errors.Is(err, ErrBlogPostInaccessible) {
+			continue
+		}
+		if err != nil {
+			log.Printf("⚠️ 게시글 %s 상세 정보 가져오기 실패: %v", listed.ID, err)
+			continue
+		}
+		posts = append(posts, post)
+	}
+	return posts, nil
+}
+
+// CrawlNeighborGraph 시작 블로그에서 이웃 목록과 댓글 작성자를 따라가며 블로그 관계 수집
+func CrawlNeighborGraph(seeds []string, opts NeighborGraphOptions) (NeighborGraph, error) {
+	opts = opts.withDefaults()
+	graph := NeighborGraph{Seeds: seeds}
+	if len(seeds) == 0 {
+		return graph, fmt.Errorf("시작 블로그가 없습니다")
+	}
+	log.Printf("🕸️ 이웃 그래프 탐색 시작... (시작 %d개, 깊이 %d, 최대 %d개 블로그)", len(seeds), opts.Depth, opts.MaxBlogs)
+
+	type edgeKey struct{ from, to, relation string }
+	weights := make(map[edgeKey]int)
+
+	visited := make(map[string]int) // 블로그 ID -> graph.Nodes 위치
+	var queue []string
+	visit := func(id string, depth int) {
+		if _, ok := visited[id]; ok || len(visited) >= opts.MaxBlogs {
+			return
+		}
+		visited[id] = len(graph.Nodes)
+		graph.Nodes = append(graph.Nodes, GraphNode{BlogID: id, Depth: depth})
+		queue = append(queue, id)
+	}
+	for _, seed := range seeds {
+		visit(seed, 0)
+	}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		// visit이 graph.Nodes에 추가하면 포인터가 무효화될 수 있으므로 위치로 접근
+		idx := visited[id]
+		depth := graph.Nodes[idx].Depth
+		log.Printf("🔄 블로그 '%s' 확인 중... (거리 %d, 방문 %d/%d)", id, depth, len(visited), opts.MaxBlogs)
+
+		var posts []BlogPost
+		if opts.CrawlPosts {
+			var err error
+			posts, err = CrawlBlog(id, opts.PostOptions)
+			if err != nil {
+				log.Printf("⚠️ 블로그 '%s' 게시글 수집 실패: %v", id, err)
+			}
+			graph.Nodes[idx].PostCount = len(posts)
+			if len(posts) > 0 {
+				if graph.Posts == nil {
+					graph.Posts = make(map[string][]BlogPost)
+				}
+				graph.Posts[id] = posts
+			}
+		}
+
+		if depth >= opts.Depth {
+			continue
+		}
+		graph.Nodes[idx].Expanded = true
+
+		neighbors, err := GetBlogNeighbors(id, opts.MaxNeighborPages)
+		if err != nil {
+			log.Printf("⚠️ 블로그 '%s' 이웃 목록 가져오기 실패: %v", id, err)
+		}
+		for _, neighbor := range neighbors {
+			weights[edgeKey{id, neighbor, RelationNeighbor}] = 1
+			visit(neighbor, depth+1)
+		}
+
+		if !opts.FollowCommenters {
+			continue
+		}
+		if !opts.CrawlPosts {
+			if posts, err = recentPosts(id, opts.CommenterPosts); err != nil {
+				log.Printf("⚠️ 블로그 '%s' 최근 게시글 가져오기 실패: %v", id, err)
+			}
+		}
+		counts := commenterCounts(posts, id)
+		commenters := make([]string, 0, len(counts))
+		for commenter := range counts {
+			commenters = append(commenters, commenter)
+		}
+		// 방문 한도에 걸렸을 때도 결과가 같도록 정렬해서 방문
+		sort.Strings(commenters)
+		for _, commenter := range commenters {
+			weights[edgeKey{commenter, id, RelationComment}] += counts[commenter]
+			visit(commenter, depth+1)
+		}
+	}
+
+	for k, w := range weights {
+		graph.Edges = append(graph.Edges, GraphEdge{From: k.from, To: k.to, Relation: k.relation, Weight: w})
+	}
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		a, b := graph.Edges[i], graph.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		return a.Relation < b.Relation
+	})
+
+	log.Printf("🎉 이웃 그래프 탐색 완료! 블로그 %d개, 관계 %d개", len(graph.Nodes), len(graph.Edges))
+	return graph, nil
+}
+
+// SaveNeighborGraph 그래프(JSON)와 관계 목록(CSV), 수집한 게시글(JSON, 있을 때만) 저장, 저장한 파일 경로 반환
+func SaveNeighborGraph(graph NeighborGraph, outputDir string) ([]string, error) {
+	timestamp := time.Now().Format("20060102_150405")
+	base := filepath.Join(outputDir, fmt.Sprintf("graph_%s_%s", strings.Join(graph.Seeds, "_"), timestamp))
+
+	jsonFile := base + ".json"
+	if err := utils.SaveToJSON(graph, jsonFile); err != nil {
+		return nil, fmt.Errorf("그래프 저장 실패: %v", err)
+	}
+
+	csvFile := base + "_edges.csv"
+	f, err := os.Create(csvFile)
+	if err != nil {
+		return nil, fmt.Errorf("관계 목록 파일 생성 실패: %v", err)
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	w.Write([]string{"from", "to", "relation", "weight"})
+	for _, e := range graph.Edges {
+		w.Write([]string{e.From, e.To, e.Relation, strconv.Itoa(e.Weight)})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("관계 목록 저장 실패: %v", err)
+	}
+	log.Printf("💾 저장 완료: %s", csvFile)
+	files := []string{jsonFile, csvFile}
+
+	if len(graph.Posts) > 0 {
+		posts := make(map[string][]map[string]interface{}, len(graph.Posts))
+		for blogID, blogPosts := range graph.Posts {
+			posts[blogID] = formatPosts(blogPosts)
+		}
+		postsFile := base + "_posts.json"
+		if err := utils.SaveToJSON(posts, postsFile); err != nil {
+			return nil, fmt.Errorf("게시글 저장 실패: %v", err)
+		}
+		files = append(files, postsFile)
+	}
+	return files, nil
+}
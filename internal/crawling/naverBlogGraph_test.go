@@ -0,0 +1,200 @@
+package crawling
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func fakeNeighbors(f *fakeNaver) {
+	f.route("/BuddyList.naver", map[string]string{"blogId": "allminwon", "currentPage": "1"}, http.StatusOK, "blog_buddy_page1.html")
+	f.route("/BuddyList.naver", map[string]string{"blogId": "allminwon", "currentPage": "2"}, http.StatusOK, "blog_buddy_page2.html")
+	f.route("/BuddyList.naver", map[string]string{"blogId": "camper01", "currentPage": "1"}, http.StatusOK, "blog_buddy_camper.html")
+}
+
+func nodeIDs(graph NeighborGraph) []string {
+	var ids []string
+	for _, n := range graph.Nodes {
+		ids = append(ids, n.BlogID)
+	}
+	return ids
+}
+
+func TestBlogIDFromURL(t *testing.T) {
+	tests := map[string]string{
+		"https://blog.naver.com/camper01":                         "camper01",
+		"https://blog.naver.com/camper01/223428124420":            "camper01",
+		"https://m.blog.naver.com/PostList.naver?blogId=foodie":   "foodie",
+		"https://blog.naver.com/PostView.naver?logNo=1":           "",
+		"https://cafe.naver.com/somecafe":                         "",
+		"https://blog.naver.com/":                                 "",
+		"https://blog.naver.com/PostView.naver?blogId=x1&logNo=1": "x1",
+	}
+	for href, want := range tests {
+		if got := blogIDFromURL(href); got != want {
+			t.Errorf("blogIDFromURL(%q) = %q, want %q", href, got, want)
+		}
+	}
+}
+
+func TestGetBlogNeighbors(t *testing.T) {
+	fake := newFakeNaver(t)
+	fakeNeighbors(fake)
+
+	neighbors, err := GetBlogNeighbors("allminwon", 5)
+	if err != nil {
+		t.Fatalf("GetBlogNeighbors: %v", err)
+	}
+	// 자기 자신, 게시글/카페 링크, 중복은 제외하고 새 이웃이 없는 3페이지에서 멈춤
+	if got := strings.Join(neighbors, ","); got != "camper01,traveler,foodie" {
+		t.Errorf("neighbors = %s", got)
+	}
+	if n := fake.count("/BuddyList.naver"); n != 3 {
+		t.Errorf("fetched %d neighbor pages, want 3", n)
+	}
+}
+
+func TestCrawlNeighborGraph(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      NeighborGraphOptions
+		wantNodes string
+		wantEdges []GraphEdge
+	}{
+		{
+			"depth 1",
+			NeighborGraphOptions{Depth: 1},
+			"allminwon,camper01,traveler,foodie",
+			[]GraphEdge{
+				{"allminwon", "camper01", RelationNeighbor, 1},
+				{"allminwon", "foodie", RelationNeighbor, 1},
+				{"allminwon", "traveler", RelationNeighbor, 1},
+			},
+		},
+		{
+			"depth 2 follows neighbors of neighbors",
+			NeighborGraphOptions{Depth: 2},
+			"allminwon,camper01,traveler,foodie,deepblog",
+			[]GraphEdge{
+				{"allminwon", "camper01", RelationNeighbor, 1},
+				{"allminwon", "foodie", RelationNeighbor, 1},
+				{"allminwon", "traveler", RelationNeighbor, 1},
+				{"camper01", "allminwon", RelationNeighbor, 1},
+				{"camper01", "deepblog", RelationNeighbor, 1},
+			},
+		},
+		{
+			"blog budget",
+			NeighborGraphOptions{Depth: 2, MaxBlogs: 2},
+			"allminwon,camper01",
+			nil,
+		},
+		{
+			"commenters",
+			NeighborGraphOptions{Depth: 1, MaxNeighborPages: 1, FollowCommenters: true},
+			"allminwon,camper01,traveler",
+			[]GraphEdge{
+				{"allminwon", "camper01", RelationNeighbor, 1},
+				{"allminwon", "traveler", RelationNeighbor, 1},
+				// 접근 가능한 최근 게시글 2개에 모두 댓글
+				{"traveler", "allminwon", RelationComment, 2},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newFakeNaver(t)
+			fakeNeighbors(fake)
+			fake.route("/PostTitleListAsync.naver", map[string]string{"blogId": "allminwon"}, http.StatusOK, "blog_list_page1.txt")
+			fake.route("/PostView.naver", map[string]string{"logNo": "223202197008"}, http.StatusOK, "blog_post_private.html")
+			fake.route("/PostView.naver", nil, http.StatusOK, "blog_post_ok.html")
+
+			graph, err := CrawlNeighborGraph([]string{"allminwon"}, tt.opts)
+			if err != nil {
+				t.Fatalf("CrawlNeighborGraph: %v", err)
+			}
+			if got := strings.Join(nodeIDs(graph), ","); got != tt.wantNodes {
+				t.Errorf("nodes = %s, want %s", got, tt.wantNodes)
+			}
+			if tt.wantEdges == nil {
+				return
+			}
+			if len(graph.Edges) != len(tt.wantEdges) {
+				t.Fatalf("edges = %+v, want %+v", graph.Edges, tt.wantEdges)
+			}
+			for i, want := range tt.wantEdges {
+				if graph.Edges[i] != want {
+					t.Errorf("edges[%d] = %+v, want %+v", i, graph.Edges[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestSaveNeighborGraph(t *testing.T) {
+	dir := t.TempDir()
+	graph := NeighborGraph{
+		Seeds: []string{"allminwon"},
+		Nodes: []GraphNode{{BlogID: "allminwon"}, {BlogID: "camper01", Depth: 1}},
+		Edges: []GraphEdge{{"allminwon", "camper01", RelationNeighbor, 1}},
+	}
+
+	files, err := SaveNeighborGraph(graph, dir)
+	if err != nil {
+		t.Fatalf("SaveNeighborGraph: %v", err)
+	}
+	if len(files) != 2 || filepath.Ext(files[0]) != ".json" {
+		t.Fatalf("files = %v", files)
+	}
+	data, _ := os.ReadFile(files[1])
+	if want := "from,to,relation,weight\nallminwon,camper01,neighbor,1\n"; string(data) != want {
+		t.Errorf("csv = %q, want %q", data, want)
+	}
+}
+
+func TestCrawlNeighborGraphPosts(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	fake := newFakeNaver(t)
+	fakeNeighbors(fake)
+	fake.route("/PostTitleListAsync.naver", map[string]string{"blogId": "allminwon"}, http.StatusOK, "blog_list_page1.txt")
+	fake.route("/PostTitleListAsync.naver", nil, http.StatusOK, "blog_list_empty.txt")
+	fake.route("/PostView.naver", map[string]string{"logNo": "223202197008"}, http.StatusOK, "blog_post_private.html")
+	fake.route("/PostView.naver", nil, http.StatusOK, "blog_post_ok.html")
+
+	graph, err := CrawlNeighborGraph([]string{"allminwon"}, NeighborGraphOptions{
+		Depth:       1,
+		MaxBlogs:    1,
+		CrawlPosts:  true,
+		PostOptions: BlogCrawlOptions{MaxPages: 1},
+	})
+	if err != nil {
+		t.Fatalf("CrawlNeighborGraph: %v", err)
+	}
+	if graph.Nodes[0].PostCount == 0 || len(graph.Posts["allminwon"]) != graph.Nodes[0].PostCount {
+		t.Fatalf("post count %d, posts %d", graph.Nodes[0].PostCount, len(graph.Posts["allminwon"]))
+	}
+
+	files, err := SaveNeighborGraph(graph, ".")
+	if err != nil {
+		t.Fatalf("SaveNeighborGraph: %v", err)
+	}
+	if len(files) != 3 || !strings.HasSuffix(files[2], "_posts.json") {
+		t.Fatalf("files = %v", files)
+	}
+	data, err := os.ReadFile(files[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved map[string][]map[string]interface{}
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatal(err)
+	}
+	if len(saved["allminwon"]) != graph.Nodes[0].PostCount || saved["allminwon"][0]["title"] == "" {
+		t.Errorf("saved posts = %v", saved)
+	}
+}
@@ -0,0 +1,79 @@
+package crawling
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// 공감 API 응답 (게시글마다 공감 종류별 개수)
+type blogLikeResponse struct {
+	Contents []struct {
+		ContentsID string `json:"contentsId"` // {blogId}_{logNo}
+		Reactions  []struct {
+			ReactionType string `json:"reactionType"`
+			Count        int    `json:"count"`
+		} `json:"reactions"`
+	} `json:"contents"`
+}
+
+// 게시글들의 공감 수 (모든 공감 종류의 합, logNo 기준)
+func getBlogLikeCounts(blogID string, logNos []string) (map[string]int, error) {
+	keys := make([]string, len(logNos))
+	for i, logNo := range logNos {
+		keys[i] = fmt.Sprintf("BLOG[%s_%s]", blogID, logNo)
+	}
+	apiURL := fmt.Sprintf("%s/v1/search/contents?suppress_response_codes=true&pool=blogid&q=%s&isDuplication=false",
+		endpoints.BlogLike, url.QueryEscape(strings.Join(keys, ",")))
+
+	blogLimiter.Wait()
+	resp, err := client.Get(apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("공감 수 요청 실패: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("공감 수 요청 실패: HTTP %d", resp.StatusCode)
+	}
+
+	var likeResponse blogLikeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&likeResponse); err != nil {
+		return nil, fmt.Errorf("JSON 파싱 실패: %v", err)
+	}
+
+	counts := make(map[string]int)
+	for _, content := range likeResponse.Contents {
+		logNo := strings.TrimPrefix(content.ContentsID, blogID+"_")
+		for _, reaction := range content.Reactions {
+			counts[logNo] += reaction.Count
+		}
+	}
+	return counts, nil
+}
+
+// 목록에서 공감 수를 받지 못한 게시글의 공감 수 채우기
+func fillBlogLikeCounts(blogID string, posts []BlogPost) error {
+	var logNos []string
+	for _, post := range posts {
+		if post.LikeCount == 0 {
+			logNos = append(logNos, post.ID)
+		}
+	}
+	if len(logNos) == 0 {
+		return nil
+	}
+
+	counts, err := getBlogLikeCounts(blogID, logNos)
+	if err != nil {
+		return err
+	}
+	for i := range posts {
+		if n, ok := counts[posts[i].ID]; ok {
+			posts[i].LikeCount = n
+		}
+	}
+	return nil
+}
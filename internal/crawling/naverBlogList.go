@@ -0,0 +1,171 @@
+package crawling
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// PostTitleListAsync 응답 파싱
+// 이 API는 JSON이 아니라 작은따옴표 문자열을 쓰는 자바스크립트 객체를 돌려주므로,
+// 문자열 안의 따옴표와 이스케이프를 보존하면서 JSON으로 바꾼 뒤 디코딩
+func parseBlogTitleList(body []byte) (NaverBlogResponse, error) {
+	var result NaverBlogResponse
+
+	jsonBody, err := jsObjectToJSON(body)
+	if err != nil {
+		return result, err
+	}
+	// 객체 뒤에 붙는 세미콜론 등은 무시하고 첫 값만 디코딩
+	if err := json.NewDecoder(bytes.NewReader(jsonBody)).Decode(&result); err != nil {
+		return result, err
+	}
+
+	for i := range result.PostList {
+		result.PostList[i].Title = decodeBlogTitle(result.PostList[i].Title)
+	}
+	return result, nil
+}
+
+// 제목은 URL 인코딩(공백은 +)된 상태에 HTML 엔티티가 섞여 있음
+func decodeBlogTitle(title string) string {
+	if decoded, err := url.QueryUnescape(title); err == nil {
+		title = decoded
+	}
+	return strings.TrimSpace(html.UnescapeString(title))
+}
+
+// 자바스크립트 객체 리터럴을 JSON으로 변환
+// 작은따옴표/큰따옴표 문자열, \' \xHH 같은 JS 이스케이프, 따옴표 없는 키, 끝에 붙은 쉼표를 처리
+func jsObjectToJSON(src []byte) ([]byte, error) {
+	var out bytes.Buffer
+	out.Grow(len(src))
+
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\'' || c == '"':
+			s, next, err := readJSString(src, i)
+			if err != nil {
+				return nil, err
+			}
+			quoted, _ := json.Marshal(s)
+			out.Write(quoted)
+			i = next
+
+		case c == ',':
+			// 닫는 괄호 바로 앞의 쉼표는 버림
+			j := i + 1
+			for j < len(src) && isJSSpace(src[j]) {
+				j++
+			}
+			if j < len(src) && (src[j] == '}' || src[j] == ']') {
+				i = j
+				continue
+			}
+			out.WriteByte(c)
+			i++
+
+		case isJSIdentStart(c):
+			j := i + 1
+			for j < len(src) && isJSIdentPart(src[j]) {
+				j++
+			}
+			word := string(src[i:j])
+			switch word {
+			case "true", "false", "null":
+				out.WriteString(word)
+			default:
+				// 따옴표 없는 키
+				quoted, _ := json.Marshal(word)
+				out.Write(quoted)
+			}
+			i = j
+
+		default:
+			out.WriteByte(c)
+			i++
+		}
+	}
+	return out.Bytes(), nil
+}
+
+// start 위치의 따옴표로 시작하는 문자열을 읽어 값과 다음 위치 반환
+func readJSString(src []byte, start int) (string, int, error) {
+	quote := src[start]
+	var sb strings.Builder
+
+	for i := start + 1; i < len(src); i++ {
+		c := src[i]
+		if c == quote {
+			return sb.String(), i + 1, nil
+		}
+		if c != '\\' {
+			sb.WriteByte(c)
+			continue
+		}
+
+		i++
+		if i >= len(src) {
+			break
+		}
+		switch e := src[i]; e {
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'x', 'u':
+			size := 2
+			if e == 'u' {
+				size = 4
+			}
+			if i+size >= len(src) {
+				return "", 0, fmt.Errorf("%d번째 문자: 잘못된 \\%c 이스케이프", i, e)
+			}
+			code, err := strconv.ParseUint(string(src[i+1:i+1+size]), 16, 32)
+			if err != nil {
+				return "", 0, fmt.Errorf("%d번째 문자: 잘못된 \\%c 이스케이프", i, e)
+			}
+			r := rune(code)
+			i += size
+			// 이모지 등은 \ud83d\ude00처럼 UTF-16 대리 쌍 두 개로 표현되므로 합쳐서 한 문자로
+			if utf16.IsSurrogate(r) && i+6 < len(src) && src[i+1] == '\\' && src[i+2] == 'u' {
+				if low, err := strconv.ParseUint(string(src[i+3:i+7]), 16, 32); err == nil {
+					if pair := utf16.DecodeRune(r, rune(low)); pair != utf8.RuneError {
+						r = pair
+						i += 6
+					}
+				}
+			}
+			sb.WriteRune(r)
+		default:
+			// \' \" \\ \/ 및 알 수 없는 이스케이프는 문자 그대로
+			sb.WriteByte(e)
+		}
+	}
+	return "", 0, fmt.Errorf("%d번째 문자에서 시작한 문자열이 닫히지 않았습니다", start)
+}
+
+func isJSSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isJSIdentStart(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isJSIdentPart(c byte) bool {
+	return isJSIdentStart(c) || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,103 @@
+package crawling
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseBlogTitleList(t *testing.T) {
+	tests := []struct {
+		name       string
+		fixture    string
+		wantTitles []string
+		wantTotal  string
+	}{
+		{"url encoded titles", "blog_list_page1.txt", []string{"제주 여행 후기", "비공개 글", "가을 캠핑"}, "5"},
+		{"quotes, escapes and entities", "blog_list_quotes.txt", []string{
+			"Tom's 카페 후기",
+			`"올해의 책" & 정리`,
+			`He said "hi" 'again' 100%`,
+			"캠핑 😀 🏕️",
+		}, "4"},
+		{"empty list", "blog_list_empty.txt", nil, "5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := os.ReadFile(filepath.Join(testdataDir, tt.fixture))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := parseBlogTitleList(body)
+			if err != nil {
+				t.Fatalf("parseBlogTitleList: %v", err)
+			}
+			if resp.ResultCode != "S" || resp.TotalCount != tt.wantTotal {
+				t.Errorf("resultCode = %q, totalCount = %q", resp.ResultCode, resp.TotalCount)
+			}
+			if len(resp.PostList) != len(tt.wantTitles) {
+				t.Fatalf("got %d posts, want %d", len(resp.PostList), len(tt.wantTitles))
+			}
+			for i, want := range tt.wantTitles {
+				if got := resp.PostList[i].Title; got != want {
+					t.Errorf("title[%d] = %q, want %q", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestJSObjectToJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"single quotes", `{'a':'b'}`, `{"a":"b"}`, false},
+		{"escaped single quote", `{'a':'it\'s'}`, `{"a":"it's"}`, false},
+		{"double quote inside single quotes", `{'a':'say "hi"'}`, `{"a":"say \"hi\""}`, false},
+		{"hex and unicode escapes", `{'a':'\x41é\/'}`, `{"a":"Aé/"}`, false},
+		{"surrogate pair", `{'a':'\ud83d\ude00!'}`, `{"a":"😀!"}`, false},
+		{"lone surrogate", `{'a':'\ud83dx'}`, "{\"a\":\"\ufffdx\"}", false},
+		{"unquoted keys and literals", `{a:true,b:null,c:[1,2]}`, `{"a":true,"b":null,"c":[1,2]}`, false},
+		{"trailing commas", `{'a':[1,2,],'b':'x' , }`, `{"a":[1,2],"b":"x" }`, false},
+		{"comma inside string is kept", `{'a':',]'}`, `{"a":",]"}`, false},
+		{"unterminated string", `{'a':'b}`, "", true},
+		{"bad hex escape", `{'a':'\xZZ'}`, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := jsObjectToJSON([]byte(tt.in))
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("jsObjectToJSON: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetBlogPostListQuotedTitles(t *testing.T) {
+	fake := newFakeNaver(t)
+	fake.route("/PostTitleListAsync.naver", nil, http.StatusOK, "blog_list_quotes.txt")
+
+	posts, err := GetBlogPostList("allminwon", 1)
+	if err != nil {
+		t.Fatalf("GetBlogPostList: %v", err)
+	}
+	if len(posts) != 4 || posts[0].Title != "Tom's 카페 후기" || posts[2].ID != "223500000003" || posts[3].Title != "캠핑 😀 🏕️" {
+		t.Errorf("unexpected posts: %+v", posts)
+	}
+}
@@ -0,0 +1,177 @@
+package crawling
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"log"
+	"naverCrawler/internal/utils"
+	"net/http"
+	"path/filepath"
+	"time"
+)
+
+// BlogProfile 블로그 자체에 대한 정보
+type BlogProfile struct {
+	BlogID            string         `json:"blog_id"`
+	Title             string         `json:"title"`
+	NickName          string         `json:"nickname"`
+	Description       string         `json:"description"`
+	ProfileImage      string         `json:"profile_image"`
+	NeighborCount     int            `json:"neighbor_count"` // 이 블로그를 이웃으로 추가한 수
+	TotalPostCount    int            `json:"total_post_count"`
+	TotalVisitorCount int            `json:"total_visitor_count"`
+	Categories        []BlogCategory `json:"categories"`
+
+	// 개설일은 공개되지 않으므로 첫 게시글과 최근 게시글 작성일로 활동 기간을 나타냄
+	FirstPostDate string `json:"first_post_date"`
+	LastPostDate  string `json:"last_post_date"`
+
+	CollectedAt string `json:"collected_at"`
+}
+
+// BlogCategory 카테고리 (하위 카테고리 포함)
+type BlogCategory struct {
+	No        int            `json:"no"`
+	Name      string         `json:"name"`
+	PostCount int            `json:"post_count"`
+	Children  []BlogCategory `json:"children,omitempty"`
+}
+
+// 모바일 블로그 정보 API 응답
+type mobileBlogInfoResponse struct {
+	IsSuccess bool `json:"isSuccess"`
+	Result    struct {
+		BlogName          string `json:"blogName"`
+		NickName          string `json:"nickName"`
+		Introduction      string `json:"introduction"`
+		ProfileImageURL   string `json:"profileImageUrl"`
+		SubscriberCount   int    `json:"subscriberCount"`
+		TotalVisitorCount int    `json:"totalVisitorCount"`
+	} `json:"result"`
+}
+
+// 모바일 카테고리 목록 API 응답 (부모 번호로 연결된 평평한 목록)
+type mobileCategoryListResponse struct {
+	IsSuccess bool `json:"isSuccess"`
+	Result    struct {
+		CategoryList []struct {
+			CategoryNo       int    `json:"categoryNo"`
+			CategoryName     string `json:"categoryName"`
+			ParentCategoryNo int    `json:"parentCategoryNo"`
+			PostCnt          int    `json:"postCnt"`
+			DivisionLine     bool   `json:"divisionLine"` // 구분선 (카테고리 아님)
+		} `json:"mylogCategoryList"`
+	} `json:"result"`
+}
+
+// 모바일 블로그 API에서 JSON 응답 디코딩
+func getMobileBlogJSON(blogID, url string, v interface{}) error {
+	resp, err := mobileBlogBackend{}.get(blogID, url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("JSON 파싱 실패: %v", err)
+	}
+	return nil
+}
+
+// GetBlogProfile 블로그 제목, 닉네임, 소개, 이웃 수, 게시글 수, 카테고리, 활동 기간 가져오기
+func GetBlogProfile(blogID string) (BlogProfile, error) {
+	profile := BlogProfile{
+		BlogID:      blogID,
+		CollectedAt: time.Now().Format(time.RFC3339),
+	}
+
+	var info mobileBlogInfoResponse
+	if err := getMobileBlogJSON(blogID, fmt.Sprintf("%s/api/blogs/%s/blog-info", endpoints.MobileBlog, blogID), &info); err != nil {
+		return profile, fmt.Errorf("블로그 정보 요청 실패: %v", err)
+	}
+	if !info.IsSuccess {
+		return profile, fmt.Errorf("API 응답 오류: 존재하지 않거나 볼 수 없는 블로그입니다")
+	}
+	profile.Title = html.UnescapeString(info.Result.BlogName)
+	profile.NickName = info.Result.NickName
+	profile.Description = html.UnescapeString(info.Result.Introduction)
+	profile.ProfileImage = info.Result.ProfileImageURL
+	profile.NeighborCount = info.Result.SubscriberCount
+	profile.TotalVisitorCount = info.Result.TotalVisitorCount
+
+	// 카테고리와 활동 기간은 없어도 프로필은 돌려줌
+	var categories mobileCategoryListResponse
+	if err := getMobileBlogJSON(blogID, fmt.Sprintf("%s/api/blogs/%s/category-list", endpoints.MobileBlog, blogID), &categories); err != nil {
+		log.Printf("⚠️ 카테고리 목록 가져오기 실패: %v", err)
+	} else {
+		profile.Categories = buildCategoryTree(categories)
+	}
+
+	if err := fillPostDates(blogID, &profile); err != nil {
+		log.Printf("⚠️ 게시글 작성일 가져오기 실패: %v", err)
+	}
+
+	return profile, nil
+}
+
+// 부모 번호로 연결된 카테고리 목록을 트리로 변환 (원래 순서 유지)
+func buildCategoryTree(resp mobileCategoryListResponse) []BlogCategory {
+	children := make(map[int][]int) // 부모 번호 -> 목록 내 위치
+	for i, c := range resp.Result.CategoryList {
+		if c.DivisionLine {
+			continue
+		}
+		children[c.ParentCategoryNo] = append(children[c.ParentCategoryNo], i)
+	}
+
+	var build func(parent int) []BlogCategory
+	build = func(parent int) []BlogCategory {
+		var tree []BlogCategory
+		for _, i := range children[parent] {
+			c := resp.Result.CategoryList[i]
+			node := BlogCategory{No: c.CategoryNo, Name: html.UnescapeString(c.CategoryName), PostCount: c.PostCnt}
+			if c.CategoryNo != parent {
+				node.Children = build(c.CategoryNo)
+			}
+			tree = append(tree, node)
+		}
+		return tree
+	}
+	return build(0)
+}
+
+// 최신 게시글과 가장 오래된 게시글을 한 개씩 조회해 게시글 수와 활동 기간 채우기
+func fillPostDates(blogID string, profile *BlogProfile) error {
+	latest, err := MobileBlogBackend.PostPage(blogID, 1, 1)
+	if err != nil {
+		return err
+	}
+	profile.TotalPostCount = latest.TotalCount
+	if len(latest.Posts) == 0 {
+		return nil
+	}
+	profile.LastPostDate = latest.Posts[0].WriteDate
+
+	oldest, err := MobileBlogBackend.PostPage(blogID, latest.TotalCount, 1)
+	if err != nil {
+		return err
+	}
+	if len(oldest.Posts) > 0 {
+		profile.FirstPostDate = oldest.Posts[0].WriteDate
+	}
+	return nil
+}
+
+// SaveBlogProfile 블로그 정보를 게시글 결과와 같은 디렉토리에 저장
+func SaveBlogProfile(profile BlogProfile, outputDir string) (string, error) {
+	timestamp := time.Now().Format("20060102_150405")
+	filename := filepath.Join(outputDir, fmt.Sprintf("blog_%s_profile_%s.json", profile.BlogID, timestamp))
+	if err := utils.SaveToJSON(profile, filename); err != nil {
+		return "", fmt.Errorf("블로그 정보 저장 실패: %v", err)
+	}
+	return filename, nil
+}
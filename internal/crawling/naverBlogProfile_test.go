@@ -0,0 +1,86 @@
+package crawling
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func fakeBlogProfile(f *fakeNaver) {
+	f.route("/m/api/blogs/allminwon/blog-info", nil, http.StatusOK, "mobile_blog_info.json")
+	f.route("/m/api/blogs/allminwon/category-list", nil, http.StatusOK, "mobile_category_list.json")
+	f.route(testMobileListPath, map[string]string{"page": "1", "itemCount": "1"}, http.StatusOK, "mobile_post_list_page1.json")
+	f.route(testMobileListPath, map[string]string{"page": "5", "itemCount": "1"}, http.StatusOK, "mobile_post_list_oldest.json")
+}
+
+func TestGetBlogProfile(t *testing.T) {
+	fake := newFakeNaver(t)
+	fakeBlogProfile(fake)
+
+	profile, err := GetBlogProfile("allminwon")
+	if err != nil {
+		t.Fatalf("GetBlogProfile: %v", err)
+	}
+
+	if profile.Title != "민원이의 여행 & 일상" || profile.NickName != "민원이" || profile.Description != "여행과 캠핑 기록" {
+		t.Errorf("profile = %+v", profile)
+	}
+	if profile.NeighborCount != 321 || profile.TotalVisitorCount != 45678 || profile.TotalPostCount != 5 {
+		t.Errorf("counts = neighbor %d, visitor %d, posts %d", profile.NeighborCount, profile.TotalVisitorCount, profile.TotalPostCount)
+	}
+	if profile.LastPostDate != "2024. 4. 25. 21:03" || profile.FirstPostDate != "2023. 1. 23. 09:00" {
+		t.Errorf("post dates = %q ~ %q", profile.FirstPostDate, profile.LastPostDate)
+	}
+
+	// 구분선은 빠지고 하위 카테고리는 부모 아래로
+	cats := profile.Categories
+	if len(cats) != 3 || cats[0].Name != "전체보기" || cats[1].Name != "여행" || cats[2].Name != "일상" {
+		t.Fatalf("categories = %+v", cats)
+	}
+	if len(cats[1].Children) != 2 || cats[1].Children[0].No != 12 || cats[1].Children[1].PostCount != 2 {
+		t.Errorf("children of 여행 = %+v", cats[1].Children)
+	}
+	if len(cats[0].Children) != 0 {
+		t.Errorf("전체보기 must not contain itself: %+v", cats[0].Children)
+	}
+}
+
+func TestGetBlogProfileMissingBlog(t *testing.T) {
+	fake := newFakeNaver(t)
+	fake.route("/m/api/blogs/nobody/blog-info", nil, http.StatusOK, "mobile_post_list_error.json")
+
+	if _, err := GetBlogProfile("nobody"); err == nil {
+		t.Error("expected error for missing blog")
+	}
+}
+
+func TestCrawlBlogSavesProfile(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	fake := newFakeNaver(t)
+	fakeBlogProfile(fake)
+	fake.route("/PostTitleListAsync.naver", nil, http.StatusOK, "blog_list_empty.txt")
+
+	// 기본값은 블로그 정보를 가져오지 않음
+	if _, err := CrawlBlog("allminwon", BlogCrawlOptions{MaxPages: 1}); err != nil {
+		t.Fatalf("CrawlBlog: %v", err)
+	}
+	if n := fake.count("/m/api/blogs/allminwon/blog-info"); n != 0 {
+		t.Errorf("fetched blog info %d times without Profile, want 0", n)
+	}
+
+	if _, err := CrawlBlog("allminwon", BlogCrawlOptions{MaxPages: 1, Profile: true}); err != nil {
+		t.Fatalf("CrawlBlog: %v", err)
+	}
+	files, _ := filepath.Glob(filepath.Join("output_blog", "blog_allminwon_profile_*.json"))
+	if len(files) != 1 {
+		t.Fatalf("profile files = %v", files)
+	}
+	data, _ := os.ReadFile(files[0])
+	var saved BlogProfile
+	if err := json.Unmarshal(data, &saved); err != nil || saved.NickName != "민원이" {
+		t.Errorf("saved profile = %+v, %v", saved, err)
+	}
+}
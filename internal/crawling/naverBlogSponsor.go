@@ -0,0 +1,118 @@
+package crawling
+
+import (
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// SponsorEvidence 광고/협찬 게시글로 판단한 근거
+type SponsorEvidence struct {
+	Kind   string `json:"kind"`   // phrase, image_host, banner, link
+	Detail string `json:"detail"` // 찾은 문구 또는 주소
+}
+
+// 대가성 표기 문구
+var sponsorPhrases = []string{
+	"소정의 원고료", "원고료를 지원", "원고료를 제공", "원고료를 받",
+	"제품을 제공받", "제품을 무상으로", "무상으로 제공받", "무료로 제공받",
+	"지원받아 작성", "제공받아 작성", "업체로부터", "업체에서 제공",
+	"협찬", "체험단", "서포터즈", "유료 광고", "광고를 포함", "#광고",
+}
+
+// 대가성이 없다는 표기 (이 문구 안의 단어는 광고 근거로 보지 않음)
+var notSponsoredPhrases = []string{
+	"협찬 아님", "협찬아님", "협찬 x", "협찬x", "협찬 없", "광고 아님", "광고아님",
+	"내돈내산", "내 돈 내 산", "내돈 내산",
+}
+
+// 체험단/원고료 플랫폼의 배너, 스티커 이미지와 링크 주소
+var sponsorHosts = []string{
+	"revu.net", "dinnerqueen.net", "reviewnote.co.kr", "seoulouba.co.kr", "mrblog.net",
+	"cometoplay.kr", "4blog.net", "reviewplace.co.kr", "ringble.co.kr", "gangnammatzip.com",
+}
+
+// 배너 이미지의 대체 텍스트/파일 이름에 자주 쓰이는 단어
+var sponsorBannerWords = []string{"원고료", "협찬", "체험단", "sponsor", "sponsored", "supporters"}
+
+func matchesSponsorHost(rawURL string) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || u.Host == "" {
+		return "", false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, h := range sponsorHosts {
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return h, true
+		}
+	}
+	return "", false
+}
+
+// 본문의 대가성 표기 문구, 체험단 플랫폼 배너/링크로 광고/협찬 게시글 판별
+func detectSponsored(doc *goquery.Document, content string) (bool, []SponsorEvidence) {
+	var evidence []SponsorEvidence
+	seen := make(map[SponsorEvidence]bool)
+	add := func(kind, detail string) {
+		e := SponsorEvidence{Kind: kind, Detail: detail}
+		if !seen[e] {
+			seen[e] = true
+			evidence = append(evidence, e)
+		}
+	}
+
+	// "협찬 아님" 같은 부정 표기는 지운 뒤 문구 확인
+	text := strings.ToLower(content)
+	for _, phrase := range notSponsoredPhrases {
+		text = strings.ReplaceAll(text, phrase, " ")
+	}
+	for _, phrase := range sponsorPhrases {
+		if strings.Contains(text, phrase) {
+			add("phrase", phrase)
+		}
+	}
+
+	body := doc.Find(".se-main-container")
+	if body.Length() == 0 {
+		body = doc.Find("body")
+	}
+
+	body.Find("img").Each(func(i int, img *goquery.Selection) {
+		for _, attr := range []string{"src", "data-lazy-src", "data-src"} {
+			src, _ := img.Attr(attr)
+			if host, ok := matchesSponsorHost(src); ok {
+				add("image_host", host)
+			}
+		}
+
+		alt := strings.ToLower(img.AttrOr("alt", "") + " " + img.AttrOr("title", "") + " " + img.AttrOr("src", ""))
+		for _, word := range sponsorBannerWords {
+			if strings.Contains(alt, word) {
+				add("banner", word)
+			}
+		}
+	})
+
+	body.Find("a[href]").Each(func(i int, a *goquery.Selection) {
+		if host, ok := matchesSponsorHost(a.AttrOr("href", "")); ok {
+			add("link", host)
+		}
+	})
+
+	return len(evidence) > 0, evidence
+}
+
+// 광고/협찬 게시글 수 출력
+func logSponsoredSummary(posts []BlogPost) {
+	n := 0
+	for _, post := range posts {
+		if post.Sponsored {
+			n++
+		}
+	}
+	if n > 0 {
+		log.Printf("📢 광고/협찬으로 판단한 게시글: %d/%d개", n, len(posts))
+	}
+}
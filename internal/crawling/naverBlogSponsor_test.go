@@ -0,0 +1,70 @@
+package crawling
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestDetectSponsored(t *testing.T) {
+	fake := newFakeNaver(t)
+	fake.route("/PostView.naver", map[string]string{"logNo": "1"}, http.StatusOK, "blog_post_ok.html")
+	fake.route("/PostView.naver", map[string]string{"logNo": "2"}, http.StatusOK, "blog_post_sponsored.html")
+	fake.route("/PostView.naver", map[string]string{"logNo": "3"}, http.StatusOK, "blog_post_own_money.html")
+
+	tests := []struct {
+		name          string
+		logNo         string
+		wantSponsored bool
+		wantEvidence  []SponsorEvidence
+	}{
+		{"plain post", "1", false, nil},
+		{"disclosure and banner", "2", true, []SponsorEvidence{
+			{Kind: "phrase", Detail: "무상으로 제공받"},
+			{Kind: "phrase", Detail: "업체로부터"},
+			{Kind: "image_host", Detail: "revu.net"},
+			{Kind: "banner", Detail: "체험단"},
+			{Kind: "link", Detail: "revu.net"},
+		}},
+		{"negated disclosure", "3", false, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post, err := GetBlogPostDetail("allminwon", tt.logNo)
+			if err != nil {
+				t.Fatalf("GetBlogPostDetail: %v", err)
+			}
+			if post.Sponsored != tt.wantSponsored {
+				t.Errorf("Sponsored = %v, want %v (evidence %+v)", post.Sponsored, tt.wantSponsored, post.SponsorEvidence)
+			}
+			if len(post.SponsorEvidence) != len(tt.wantEvidence) {
+				t.Fatalf("SponsorEvidence = %+v, want %+v", post.SponsorEvidence, tt.wantEvidence)
+			}
+			for i, e := range tt.wantEvidence {
+				if post.SponsorEvidence[i] != e {
+					t.Errorf("SponsorEvidence[%d] = %+v, want %+v", i, post.SponsorEvidence[i], e)
+				}
+			}
+		})
+	}
+}
+
+func TestMatchesSponsorHost(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+		ok   bool
+	}{
+		{"https://cdn.revu.net/banner.png", "revu.net", true},
+		{"https://dinnerqueen.net/taste/1", "dinnerqueen.net", true},
+		{"https://notrevu.net/banner.png", "", false},
+		{"https://postfiles.pstatic.net/a.jpg", "", false},
+		{"/relative/path.png", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := matchesSponsorHost(tt.url)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("matchesSponsorHost(%q) = %q, %v, want %q, %v", tt.url, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package crawling
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// BlogPostStatus 블로그 게시글 접근 상태
+type BlogPostStatus string
+
+const (
+	BlogPostOK           BlogPostStatus = "ok"            // 정상
+	BlogPostPrivate      BlogPostStatus = "private"       // 비공개
+	BlogPostNeighborOnly BlogPostStatus = "neighbor_only" // 이웃/서로이웃 공개
+	BlogPostAdult        BlogPostStatus = "adult"         // 성인인증 필요
+	BlogPostDeleted      BlogPostStatus = "deleted"       // 삭제되었거나 존재하지 않음
+	BlogPostEmpty        BlogPostStatus = "empty"         // 원인 불명의 빈 페이지
+)
+
+// 접근 제한 페이지에 표시되는 안내 문구 (위에서부터 순서대로 확인)
+var blogStatusPhrases = []struct {
+	status  BlogPostStatus
+	phrases []string
+}{
+	{BlogPostDeleted, []string{"삭제되었거나 존재하지 않는", "존재하지 않는 게시물", "삭제된 게시물", "삭제되었습니다"}},
+	{BlogPostAdult, []string{"성인인증", "19세 미만", "청소년에게 유해한"}},
+	{BlogPostNeighborOnly, []string{"서로이웃에게만 공개", "서로이웃 공개", "이웃에게만 공개", "이웃공개"}},
+	{BlogPostPrivate, []string{"비공개 글", "비공개 게시물", "비공개로 설정"}},
+}
+
+// 본문이 없는 페이지에서 접근 제한 사유 판별
+func detectBlogPostStatus(doc *goquery.Document, title, content string) (BlogPostStatus, string) {
+	// 본문이 있으면 정상 (본문에 "비공개" 같은 단어가 있어도 오탐하지 않도록)
+	if content != "" {
+		return BlogPostOK, ""
+	}
+
+	pageText := strings.Join(strings.Fields(doc.Find("body").Text()), " ")
+	for _, entry := range blogStatusPhrases {
+		for _, phrase := range entry.phrases {
+			if strings.Contains(pageText, phrase) {
+				return entry.status, phrase
+			}
+		}
+	}
+
+	// 제목이 블로그 기본 제목뿐이면 자리표시 페이지
+	if title == "" || title == "네이버 블로그" {
+		return BlogPostEmpty, "본문과 제목이 없는 페이지"
+	}
+	return BlogPostOK, ""
+}
+
+// 건너뛴 게시글 상태별 개수 요약 출력
+func logBlogStatusSummary(skipped []BlogPost) {
+	if len(skipped) == 0 {
+		return
+	}
+
+	counts := make(map[BlogPostStatus]int)
+	for _, post := range skipped {
+		counts[post.Status]++
+	}
+
+	var parts []string
+	for status, count := range counts {
+		parts = append(parts, fmt.Sprintf("%s %d개", status, count))
+	}
+	sort.Strings(parts)
+	log.Printf("🚫 접근할 수 없어 건너뛴 게시글: %s", strings.Join(parts, ", "))
+}
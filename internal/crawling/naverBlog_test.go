@@ -0,0 +1,448 @@
+package crawling
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"naverCrawler/internal/chunk"
+	"naverCrawler/internal/dedup"
+	"naverCrawler/internal/redact"
+	"naverCrawler/internal/textproc"
+)
+
+func TestGetBlogPostList(t *testing.T) {
+	fake := newFakeNaver(t)
+	fake.route("/PostTitleListAsync.naver", map[string]string{"blogId": "allminwon", "currentPage": "1"}, http.StatusOK, "blog_list_page1.txt")
+	fake.route("/PostTitleListAsync.naver", map[string]string{"blogId": "nobody"}, http.StatusOK, "blog_list_error.txt")
+
+	posts, err := GetBlogPostList("allminwon", 1)
+	if err != nil {
+		t.Fatalf("GetBlogPostList: %v", err)
+	}
+
+	wantIDs := []string{"223428124420", "223202197008", "223009170287"}
+	if len(posts) != len(wantIDs) {
+		t.Fatalf("got %d posts, want %d", len(posts), len(wantIDs))
+	}
+	for i, id := range wantIDs {
+		if posts[i].ID != id {
+			t.Errorf("posts[%d].ID = %q, want %q", i, posts[i].ID, id)
+		}
+		if want := "https://blog.naver.com/allminwon/" + id; posts[i].OriginalURL != want {
+			t.Errorf("posts[%d].OriginalURL = %q, want %q", i, posts[i].OriginalURL, want)
+		}
+	}
+
+	if _, err := GetBlogPostList("nobody", 1); err == nil {
+		t.Error("expected error for resultCode E, got nil")
+	}
+}
+
+func TestGetBlogPostDetail(t *testing.T) {
+	fake := newFakeNaver(t)
+	fake.route("/PostView.naver", map[string]string{"logNo": "1"}, http.StatusOK, "blog_post_ok.html")
+	fake.route("/PostView.naver", map[string]string{"logNo": "2"}, http.StatusOK, "blog_post_private.html")
+	fake.route("/PostView.naver", map[string]string{"logNo": "3"}, http.StatusOK, "blog_post_neighbor.html")
+	fake.route("/PostView.naver", map[string]string{"logNo": "4"}, http.StatusOK, "blog_post_adult.html")
+	fake.route("/PostView.naver", map[string]string{"logNo": "5"}, http.StatusOK, "blog_post_deleted.html")
+
+	tests := []struct {
+		name       string
+		logNo      string
+		wantStatus BlogPostStatus
+	}{
+		{"ok", "1", BlogPostOK},
+		{"private", "2", BlogPostPrivate},
+		{"neighbor only", "3", BlogPostNeighborOnly},
+		{"adult", "4", BlogPostAdult},
+		{"deleted", "5", BlogPostDeleted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post, err := GetBlogPostDetail("allminwon", tt.logNo)
+			if post.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q", post.Status, tt.wantStatus)
+			}
+			if tt.wantStatus != BlogPostOK {
+				if !errors.Is(err, ErrBlogPostInaccessible) {
+					t.Errorf("err = %v, want ErrBlogPostInaccessible", err)
+				}
+				if post.Title != "" || post.Content != "" {
+					t.Errorf("inaccessible post should not carry placeholder data: %+v", post)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetBlogPostDetail: %v", err)
+			}
+			if post.Title != "제주 여행 후기" {
+				t.Errorf("Title = %q", post.Title)
+			}
+			if post.OriginalURL != "https://blog.naver.com/allminwon/1" {
+				t.Errorf("OriginalURL = %q", post.OriginalURL)
+			}
+			if post.Writer != "민원이" {
+				t.Errorf("Writer = %q", post.Writer)
+			}
+			if post.WriteDate != "2024. 4. 25. 21:03" {
+				t.Errorf("WriteDate = %q", post.WriteDate)
+			}
+			if post.Content != "제주도에 다녀왔습니다. 날씨가 정말 좋았어요!" {
+				t.Errorf("Content = %q", post.Content)
+			}
+			if len(post.Comments) != 1 || post.Comments[0].Content != "사진이 멋지네요" || post.Comments[0].Writer != "여행자" || post.Comments[0].WriterBlogID != "traveler" {
+				t.Errorf("Comments = %+v", post.Comments)
+			}
+			if len(post.Tags) != 2 || post.Tags[0] != "제주" || post.Tags[1] != "여행" {
+				t.Errorf("Tags = %q", post.Tags)
+			}
+			if post.CategoryName != "국내 여행" {
+				t.Errorf("CategoryName = %q", post.CategoryName)
+			}
+			if post.Thumbnail != "https://blogthumb.pstatic.net/MjAyNDA0MjVfMTAw/sample.jpg?type=w2" {
+				t.Errorf("Thumbnail = %q", post.Thumbnail)
+			}
+		})
+	}
+}
+
+func TestCrawlBlog(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	fake := newFakeNaver(t)
+	fake.route("/PostTitleListAsync.naver", map[string]string{"currentPage": "1"}, http.StatusOK, "blog_list_page1.txt")
+	fake.route("/PostTitleListAsync.naver", map[string]string{"currentPage": "2"}, http.StatusOK, "blog_list_page2.txt")
+	fake.route("/PostTitleListAsync.naver", nil, http.StatusOK, "blog_list_empty.txt")
+	fake.route("/PostView.naver", map[string]string{"logNo": "223202197008"}, http.StatusOK, "blog_post_private.html")
+	fake.route("/PostView.naver", map[string]string{"logNo": "223003019400"}, http.StatusOK, "blog_post_neighbor.html")
+	fake.route("/PostView.naver", nil, http.StatusOK, "blog_post_ok.html")
+	fake.route("/like/v1/search/contents", nil, http.StatusOK, "blog_like.json")
+
+	fake.setLatency(20 * time.Millisecond)
+
+	posts, err := CrawlBlog("allminwon", BlogCrawlOptions{MaxPages: 3, Concurrency: 2})
+	if err != nil {
+		t.Fatalf("CrawlBlog: %v", err)
+	}
+
+	wantIDs := []string{"223428124420", "223009170287", "222996100708"}
+	if len(posts) != len(wantIDs) {
+		t.Fatalf("got %d posts, want %d", len(posts), len(wantIDs))
+	}
+	for i, id := range wantIDs {
+		if posts[i].ID != id {
+			t.Errorf("posts[%d].ID = %q, want %q", i, posts[i].ID, id)
+		}
+	}
+
+	// 목록의 조회수/댓글 수/카테고리와 공감 API의 공감 수 (종류별 합)
+	first := posts[0]
+	if first.ReadCount != 120 || first.CommentCount != 3 || first.LikeCount != 7 || first.CategoryNo != 12 {
+		t.Errorf("engagement of first post = read %d, comment %d, like %d, category %d",
+			first.ReadCount, first.CommentCount, first.LikeCount, first.CategoryNo)
+	}
+	if posts[1].LikeCount != 1 || posts[2].LikeCount != 0 {
+		t.Errorf("like counts = %d, %d", posts[1].LikeCount, posts[2].LikeCount)
+	}
+	if n := fake.count("/like/v1/search/contents"); n != 2 {
+		t.Errorf("fetched like counts %d times, want once per page", n)
+	}
+
+	if n := fake.count("/PostView.naver"); n != 5 {
+		t.Errorf("fetched %d details, want 5", n)
+	}
+	// totalCount 5, countPerPage 3 이므로 3페이지는 요청하지 않음
+	if n := fake.count("/PostTitleListAsync.naver"); n != 2 {
+		t.Errorf("fetched %d list pages, want 2", n)
+	}
+	// 겹치는지는 실행 환경의 속도에 달려 있으므로 상한만 확인
+	if n := fake.peakInFlight(); n > 2 {
+		t.Errorf("max concurrent requests = %d, want <= 2", n)
+	}
+
+	files, _ := filepath.Glob(filepath.Join("output_blog", "blog_allminwon_full_*.json"))
+	if len(files) != 1 {
+		t.Fatalf("full result files = %v", files)
+	}
+	if info, err := os.Stat(files[0]); err != nil || info.Size() == 0 {
+		t.Errorf("full result file not written: %v", err)
+	}
+}
+
+func TestCrawlBlogPaging(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         BlogCrawlOptions
+		wantPageSize string
+		wantPages    int
+	}{
+		{"whole blog with default page size", BlogCrawlOptions{}, "30", 2},
+		{"max pages", BlogCrawlOptions{MaxPages: 1, PageSize: 10}, "10", 1},
+		{"max pages beyond the end", BlogCrawlOptions{MaxPages: 50, PageSize: 12}, "15", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Chdir(t.TempDir())
+
+			fake := newFakeNaver(t)
+			fake.route("/PostTitleListAsync.naver", map[string]string{"currentPage": "1", "countPerPage": tt.wantPageSize}, http.StatusOK, "blog_list_page1.txt")
+			fake.route("/PostTitleListAsync.naver", map[string]string{"currentPage": "2", "countPerPage": tt.wantPageSize}, http.StatusOK, "blog_list_page2.txt")
+			fake.route("/PostView.naver", nil, http.StatusOK, "blog_post_ok.html")
+
+			posts, err := CrawlBlog("allminwon", tt.opts)
+			if err != nil {
+				t.Fatalf("CrawlBlog: %v", err)
+			}
+			if n := fake.count("/PostTitleListAsync.naver"); n != tt.wantPages {
+				t.Errorf("fetched %d list pages, want %d", n, tt.wantPages)
+			}
+			if want := min(5, tt.wantPages*3); len(posts) != want {
+				t.Errorf("got %d posts, want %d", len(posts), want)
+			}
+		})
+	}
+}
+
+func TestCrawlBlogOnPage(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	fake := newFakeNaver(t)
+	fake.route("/PostTitleListAsync.naver", map[string]string{"currentPage": "1"}, http.StatusOK, "blog_list_page1.txt")
+	fake.route("/PostTitleListAsync.naver", map[string]string{"currentPage": "2"}, http.StatusOK, "blog_list_page2.txt")
+	fake.route("/PostView.naver", nil, http.StatusOK, "blog_post_ok.html")
+
+	var mu sync.Mutex
+	seen := map[int]int{}
+	posts, err := CrawlBlog("allminwon", BlogCrawlOptions{MaxPages: 2, OnPage: func(page, total int, posts []BlogPost) {
+		mu.Lock()
+		defer mu.Unlock()
+		if total != 2 {
+			t.Errorf("page %d total = %d, want 2", page, total)
+		}
+		seen[page] += len(posts)
+	}})
+	if err != nil {
+		t.Fatalf("CrawlBlog: %v", err)
+	}
+	if len(seen) != 2 || seen[1]+seen[2] != len(posts) {
+		t.Errorf("OnPage saw %v, want both pages and %d posts", seen, len(posts))
+	}
+}
+
+func TestBlogPageCount(t *testing.T) {
+	tests := []struct{ total, perPage, want int }{
+		{0, 30, 0},
+		{1, 30, 1},
+		{30, 30, 1},
+		{31, 30, 2},
+		{2000, 30, 67},
+		{10, 0, 0},
+	}
+	for _, tt := range tests {
+		if got := blogPageCount(tt.total, tt.perPage); got != tt.want {
+			t.Errorf("blogPageCount(%d, %d) = %d, want %d", tt.total, tt.perPage, got, tt.want)
+		}
+	}
+
+	for size, want := range map[int]int{0: 5, 5: 5, 7: 10, 30: 30, 100: 30} {
+		if got := normalizeBlogPageSize(size); got != want {
+			t.Errorf("normalizeBlogPageSize(%d) = %d, want %d", size, got, want)
+		}
+	}
+}
+
+// 임시 디렉토리에서 목록 1페이지(게시글 3개, 그중 223202197008은 비공개)를 돌려주는 가짜 서버
+func fakeBlogPage1(t *testing.T) *fakeNaver {
+	t.Helper()
+	t.Chdir(t.TempDir())
+
+	fake := newFakeNaver(t)
+	fake.route("/PostTitleListAsync.naver", map[string]string{"currentPage": "1"}, http.StatusOK, "blog_list_page1.txt")
+	fake.route("/PostView.naver", map[string]string{"logNo": "223202197008"}, http.StatusOK, "blog_post_private.html")
+	fake.route("/PostView.naver", nil, http.StatusOK, "blog_post_ok.html")
+	return fake
+}
+
+func TestCrawlBlogDedup(t *testing.T) {
+	fake := fakeBlogPage1(t)
+
+	ix := dedup.New()
+	ix.MinRunes = 10 // 픽스처 본문이 짧으므로
+
+	posts, err := CrawlBlog("allminwon", BlogCrawlOptions{MaxPages: 1, Dedup: ix})
+	if err != nil {
+		t.Fatalf("CrawlBlog: %v", err)
+	}
+	// 같은 본문을 가진 두 게시글은 먼저 나온 게시글의 클러스터로 묶임
+	if len(posts) != 2 {
+		t.Fatalf("got %d posts, want 2", len(posts))
+	}
+	for _, post := range posts {
+		if post.DuplicateCluster != "blog:allminwon/223428124420" {
+			t.Errorf("post %s cluster = %q", post.ID, post.DuplicateCluster)
+		}
+	}
+
+	// 다시 실행하면 이미 수집한 게시글은 제외
+	posts, err = CrawlBlog("allminwon", BlogCrawlOptions{MaxPages: 1, Dedup: ix})
+	if err != nil {
+		t.Fatalf("second CrawlBlog: %v", err)
+	}
+	if len(posts) != 0 {
+		t.Errorf("second run returned %d posts, want 0", len(posts))
+	}
+	// 이미 수집한 게시글과 비공개 글(지문 없이 인덱스에 있음)은 본문을 다시 요청하지 않음
+	if n := fake.count("/PostView.naver"); n != 3 {
+		t.Errorf("fetched %d details over two runs, want 3", n)
+	}
+}
+
+func TestCrawlBlogStopsAtCollectedPage(t *testing.T) {
+	fake := fakeBlogPage1(t)
+	fake.route("/PostTitleListAsync.naver", map[string]string{"currentPage": "2"}, http.StatusOK, "blog_list_page2.txt")
+
+	// 1페이지 게시글을 모두 이전 실행에서 수집한 경우
+	ix := dedup.New()
+	for _, id := range []string{"223428124420", "223202197008", "223009170287"} {
+		ix.Add("blog", "allminwon/"+id, id)
+	}
+
+	posts, err := CrawlBlog("allminwon", BlogCrawlOptions{Concurrency: 1, Dedup: ix})
+	if err != nil {
+		t.Fatalf("CrawlBlog: %v", err)
+	}
+	if len(posts) != 0 {
+		t.Errorf("got %d posts, want 0", len(posts))
+	}
+	if n := fake.count("/PostTitleListAsync.naver"); n != 1 {
+		t.Errorf("fetched %d list pages, want only page 1", n)
+	}
+	if n := fake.count("/PostView.naver"); n != 0 {
+		t.Errorf("fetched %d details, want 0", n)
+	}
+}
+
+func TestCrawlBlogRedact(t *testing.T) {
+	fakeBlogPage1(t)
+
+	r := redact.New("test-salt")
+	posts, err := CrawlBlog("allminwon", BlogCrawlOptions{MaxPages: 1, Redact: r})
+	if err != nil {
+		t.Fatalf("CrawlBlog: %v", err)
+	}
+	if len(posts) == 0 {
+		t.Fatal("no posts")
+	}
+	for _, post := range posts {
+		if post.Writer != r.Writer("민원이") {
+			t.Errorf("post %s Writer = %q", post.ID, post.Writer)
+		}
+		if len(post.Comments) != 1 || post.Comments[0].Writer != r.Writer("여행자") || post.Comments[0].WriterBlogID != r.Writer("traveler") {
+			t.Errorf("post %s Comments = %+v", post.ID, post.Comments)
+		}
+		if post.Content != "제주도에 다녀왔습니다. 날씨가 정말 좋았어요!" {
+			t.Errorf("post %s Content = %q", post.ID, post.Content)
+		}
+	}
+}
+
+func TestCrawlBlogTextFeatures(t *testing.T) {
+	fakeBlogPage1(t)
+
+	posts, err := CrawlBlog("allminwon", BlogCrawlOptions{MaxPages: 1, Text: &textproc.Options{
+		Sentences: true,
+		Tokenizer: textproc.SimpleTokenizer{},
+	}})
+	if err != nil {
+		t.Fatalf("CrawlBlog: %v", err)
+	}
+	if len(posts) == 0 {
+		t.Fatal("no posts")
+	}
+
+	f := posts[0].TextFeatures
+	if f == nil {
+		t.Fatal("TextFeatures not set")
+	}
+	wantSentences := []string{"제주도에 다녀왔습니다.", "날씨가 정말 좋았어요!"}
+	if !reflect.DeepEqual(f.Sentences, wantSentences) {
+		t.Errorf("Sentences = %q, want %q", f.Sentences, wantSentences)
+	}
+	wantTokens := []string{"제주도", "다녀왔습니다", "날씨가", "정말", "좋았어요"}
+	if !reflect.DeepEqual(f.Tokens, wantTokens) {
+		t.Errorf("Tokens = %q, want %q", f.Tokens, wantTokens)
+	}
+}
+
+// JSONL 파일의 청크 읽기
+func readChunks(t *testing.T, pattern string) []chunk.Chunk {
+	t.Helper()
+	files, _ := filepath.Glob(pattern)
+	if len(files) != 1 {
+		t.Fatalf("chunk files = %v, want 1", files)
+	}
+	f, err := os.Open(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var chunks []chunk.Chunk
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var c chunk.Chunk
+		if err := json.Unmarshal(sc.Bytes(), &c); err != nil {
+			t.Fatalf("bad JSONL line %q: %v", sc.Text(), err)
+		}
+		chunks = append(chunks, c)
+	}
+	return chunks
+}
+
+func TestCrawlBlogChunks(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	fake := newFakeNaver(t)
+	fake.route("/PostTitleListAsync.naver", map[string]string{"currentPage": "1"}, http.StatusOK, "blog_list_page1.txt")
+	fake.route("/PostView.naver", map[string]string{"logNo": "223428124420"}, http.StatusOK, "blog_post_ok.html")
+	fake.route("/PostView.naver", nil, http.StatusOK, "blog_post_private.html")
+
+	_, err := CrawlBlog("allminwon", BlogCrawlOptions{MaxPages: 1, Chunks: &chunk.Options{MaxChars: 15, IncludeComments: true}})
+	if err != nil {
+		t.Fatalf("CrawlBlog: %v", err)
+	}
+
+	chunks := readChunks(t, filepath.Join("output_blog", "blog_allminwon_chunks_*.jsonl"))
+	// 문단 두 개는 15자 안에 함께 들어가지 않으므로 나뉘고, 댓글은 따로
+	want := []struct{ kind, text string }{
+		{chunk.KindContent, "제주도에 다녀왔습니다."},
+		{chunk.KindContent, "날씨가 정말 좋았어요!"},
+		{chunk.KindComments, "여행자: 사진이 멋지네요"},
+	}
+	if len(chunks) != len(want) {
+		t.Fatalf("got %d chunks, want %d: %+v", len(chunks), len(want), chunks)
+	}
+	for i, w := range want {
+		c := chunks[i]
+		if c.Kind != w.kind || c.Text != w.text {
+			t.Errorf("chunks[%d] = %s %q, want %s %q", i, c.Kind, c.Text, w.kind, w.text)
+		}
+		if c.ID != fmt.Sprintf("blog:allminwon/223428124420#%d", i) || c.ChunkCount != 3 {
+			t.Errorf("chunks[%d] ID = %q, ChunkCount = %d", i, c.ID, c.ChunkCount)
+		}
+		if c.URL != "https://blog.naver.com/allminwon/223428124420" || c.Title != "제주 여행 후기" || c.Writer != "민원이" {
+			t.Errorf("chunks[%d] metadata = %+v", i, c)
+		}
+	}
+}
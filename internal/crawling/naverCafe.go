@@ -2,17 +2,24 @@ package crawling
 
 import (
 	"context"
-	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 
+	"naverCrawler/internal/chunk"
+	"naverCrawler/internal/dedup"
+	"naverCrawler/internal/redact"
+	"naverCrawler/internal/textproc"
+
 	"golang.org/x/sync/errgroup"
 )
 
@@ -22,16 +29,6 @@ func randomSleep() {
 	time.Sleep(sleepTime)
 }
 
-// HTTP 클라이언트 설정
-var client = &http.Client{
-	Transport: &http.Transport{
-		TLSClientConfig: &tls.Config{
-			MinVersion: tls.VersionTLS12,
-		},
-	},
-	Timeout: 10 * time.Second,
-}
-
 // 응답 구조체
 type ArticleListResponse struct {
 	Result struct {
@@ -46,6 +43,7 @@ type ArticleListResponse struct {
 				ReadCount          int    `json:"readCount"`
 				LikeCount          int    `json:"likeCount"`
 				WriterInfo         struct {
+					MemberKey       string `json:"memberKey"`
 					NickName        string `json:"nickName"`
 					MemberLevel     int    `json:"memberLevel"`
 					MemberLevelName string `json:"memberLevelName"`
@@ -54,10 +52,7 @@ type ArticleListResponse struct {
 				} `json:"writerInfo"`
 			} `json:"item"`
 		} `json:"articleList"`
-		PageInfo struct {
-			LastNavigationPageNumber int  `json:"lastNavigationPageNumber"`
-			VisibleNextButton        bool `json:"visibleNextButton"`
-		} `json:"pageInfo"`
+		PageInfo CafePageInfo `json:"pageInfo"`
 	} `json:"result"`
 }
 
@@ -71,15 +66,17 @@ type ArticleDetailResponse struct {
 			Subject      string `json:"subject"`
 			WriteDate    int64  `json:"writeDate"`
 			Writer       struct {
+				MemberKey       string `json:"memberKey"`
 				NickName        string `json:"nickName"`
 				MemberLevel     int    `json:"memberLevel"`
 				MemberLevelName string `json:"memberLevelName"`
 				Staff           bool   `json:"staff"`
 				Manager         bool   `json:"manager"`
 			} `json:"writer"`
-			CommentCount int `json:"commentCount"`
-			ReadCount    int `json:"readCount"`
-			LikeCount    int `json:"likeCount"`
+			CommentCount int  `json:"commentCount"`
+			ReadCount    int  `json:"readCount"`
+			LikeCount    int  `json:"likeCount"`
+			IsBlind      bool `json:"isBlind"`
 		} `json:"article"`
 		Comments struct {
 			Items []struct {
@@ -87,6 +84,7 @@ type ArticleDetailResponse struct {
 				Content   string `json:"content"`
 				WriteDate int64  `json:"writeDate"`
 				Writer    struct {
+					MemberKey       string `json:"memberKey"`
 					NickName        string `json:"nickName"`
 					MemberLevel     int    `json:"memberLevel"`
 					MemberLevelName string `json:"memberLevelName"`
@@ -100,48 +98,61 @@ type ArticleDetailResponse struct {
 }
 
 // HTTP 요청 보내고 응답 반환하는 함수
-func getAPIResponse(url, cookie string) (*http.Response, error) {
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
+// 로그인이 필요한 응답을 받으면 ErrSessionExpired를 반환하거나, 쿠키 파일 갱신을 기다렸다가 재시도
+func getAPIResponse(url string, session *Session) (*http.Response, error) {
+	for {
+		generation := session.currentGeneration()
 
-	// 필수 헤더만 설정
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/136.0.0.0 Safari/537.36")
-	req.Header.Set("Cookie", cookie)
-	req.Header.Set("Referer", "https://cafe.naver.com")
-	req.Header.Set("Origin", "https://cafe.naver.com")
-	req.Header.Set("X-Cafe-Product", "pc")
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
 
-	randomSleep()
+		// 카페 API 전용 헤더 (User-Agent 등 공통 헤더는 headerTransport에서 설정)
+		req.Header.Set("Cookie", session.CookieHeader())
+		req.Header.Set("Referer", "https://cafe.naver.com")
+		req.Header.Set("Origin", "https://cafe.naver.com")
+		req.Header.Set("X-Cafe-Product", "pc")
 
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
+		requestDelay()
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode == http.StatusOK && !isLoginWall(resp, nil) {
+			return resp, nil
+		}
 
-	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
 		resp.Body.Close()
-		return nil, fmt.Errorf("HTTP 오류: %d", resp.StatusCode)
-	}
 
-	return resp, nil
+		if isLoginWall(resp, body) {
+			if err := session.waitForRefresh(generation); err != nil {
+				return nil, fmt.Errorf("HTTP %d: %w", resp.StatusCode, err)
+			}
+			continue
+		}
+
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: body}
+	}
 }
 
 // 게시글 목록 가져오기
-func getPostList(cafeId, boardID string, page int, pageSize int, cookie string) ([]map[string]interface{}, int, error) {
-	url := fmt.Sprintf("https://apis.naver.com/cafe-web/cafe-boardlist-api/v1/cafes/%s/menus/%s/articles?page=%d&pageSize=%d&sortBy=TIME&viewType=L",
-		cafeId, boardID, page, pageSize)
+func getPostList(cafeId, boardID string, page int, pageSize int, session *Session) ([]map[string]interface{}, CafePageInfo, error) {
+	url := fmt.Sprintf("%s/cafe-web/cafe-boardlist-api/v1/cafes/%s/menus/%s/articles?page=%d&pageSize=%d&sortBy=TIME&viewType=L",
+		endpoints.CafeAPI, cafeId, boardID, page, pageSize)
 
-	resp, err := getAPIResponse(url, cookie)
+	resp, err := getAPIResponse(url, session)
 	if err != nil {
-		return nil, 0, err
+		return nil, CafePageInfo{}, err
 	}
 	defer resp.Body.Close()
 
 	var result ArticleListResponse
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, 0, err
+		return nil, CafePageInfo{}, err
 	}
 
 	var posts []map[string]interface{}
@@ -151,6 +162,7 @@ func getPostList(cafeId, boardID string, page int, pageSize int, cookie string)
 				"id":            article.Item.ArticleId,
 				"title":         article.Item.Subject,
 				"writer":        article.Item.WriterInfo.NickName,
+				"member_key":    article.Item.WriterInfo.MemberKey,
 				"writer_level":  article.Item.WriterInfo.MemberLevelName,
 				"is_staff":      article.Item.WriterInfo.Staff,
 				"is_manager":    article.Item.WriterInfo.Manager,
@@ -161,25 +173,47 @@ func getPostList(cafeId, boardID string, page int, pageSize int, cookie string)
 			})
 		}
 	}
-	return posts, result.Result.PageInfo.LastNavigationPageNumber, nil
+	return posts, result.Result.PageInfo, nil
 }
 
 // 게시글 상세 정보 가져오기
-func getArticleDetail(cafeId string, articleId int, cookie string) (map[string]interface{}, error) {
-	url := fmt.Sprintf("https://apis.naver.com/cafe-web/cafe-articleapi/v3/cafes/%s/articles/%d?query=&useCafeId=true&requestFrom=A",
-		cafeId, articleId)
-
-	resp, err := getAPIResponse(url, cookie)
+func getArticleDetail(cafeId string, articleId int, session *Session) (map[string]interface{}, error) {
+	url := fmt.Sprintf("%s/cafe-web/cafe-articleapi/v3/cafes/%s/articles/%d?query=&useCafeId=true&requestFrom=A",
+		endpoints.CafeAPI, cafeId, articleId)
+
+	resp, err := getAPIResponse(url, session)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		// 삭제/권한 없음 등은 오류 대신 상태로 기록 (일시적인 오류는 호출하는 쪽에서 건너뛰도록 그대로 반환)
+		if status, reason, ok := classifyArticleError(apiErr.StatusCode, apiErr.Body); ok {
+			return inaccessibleArticle(articleId, status, reason), nil
+		}
+		return nil, err
+	}
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
 	var result ArticleDetailResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := json.Unmarshal(body, &result); err != nil {
 		return nil, err
 	}
 
+	// 200 응답이지만 게시글 대신 오류 정보가 온 경우
+	if result.Result.Article.ID == 0 {
+		status, reason, ok := classifyArticleError(resp.StatusCode, body)
+		if !ok {
+			return nil, fmt.Errorf("게시글 %d 응답에 게시글 정보가 없습니다: %s", articleId, reason)
+		}
+		return inaccessibleArticle(articleId, status, reason), nil
+	}
+
 	// 게시글 정보 구성
 	article := result.Result.Article
 	articleDetail := map[string]interface{}{
@@ -187,6 +221,7 @@ func getArticleDetail(cafeId string, articleId int, cookie string) (map[string]i
 		"title":         article.Subject,
 		"content_html":  article.ContentHtml,
 		"writer":        article.Writer.NickName,
+		"member_key":    article.Writer.MemberKey,
 		"writer_level":  article.Writer.MemberLevelName,
 		"is_staff":      article.Writer.Staff,
 		"is_manager":    article.Writer.Manager,
@@ -194,6 +229,10 @@ func getArticleDetail(cafeId string, articleId int, cookie string) (map[string]i
 		"comment_count": article.CommentCount,
 		"read_count":    article.ReadCount,
 		"like_count":    article.LikeCount,
+		"status":        ArticleOK,
+	}
+	if article.IsBlind {
+		articleDetail["status"] = ArticleBlinded
 	}
 
 	// 댓글 정보 구성
@@ -203,6 +242,7 @@ func getArticleDetail(cafeId string, articleId int, cookie string) (map[string]i
 			"id":           comment.ID,
 			"content":      comment.Content,
 			"writer":       comment.Writer.NickName,
+			"member_key":   comment.Writer.MemberKey,
 			"writer_level": comment.Writer.MemberLevelName,
 			"is_staff":     comment.Writer.Staff,
 			"is_manager":   comment.Writer.Manager,
@@ -215,144 +255,308 @@ func getArticleDetail(cafeId string, articleId int, cookie string) (map[string]i
 	return articleDetail, nil
 }
 
+// 접근할 수 없는 게시글의 상세 정보 (본문/댓글 없이 상태만 기록)
+func inaccessibleArticle(articleId int, status ArticleStatus, reason string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":            articleId,
+		"content_html":  "",
+		"comments":      []map[string]interface{}(nil),
+		"status":        status,
+		"status_reason": reason,
+	}
+}
+
+// CafeCrawlOptions 카페 게시판 크롤링 설정
+type CafeCrawlOptions struct {
+	StartPage     int // 시작 페이지 (기본값: 1)
+	EndPage       int // 마지막 페이지 (0은 게시판 끝까지)
+	MaxPages      int // 최대 페이지 수 (0은 무제한)
+	PageSize      int // 페이지당 게시글 수
+	ListWorkers   int // 동시에 목록 페이지를 가져올 작업자 수 (기본값: 3)
+	DetailWorkers int // 동시에 게시글 상세 정보를 가져올 작업자 수 (기본값: 3)
+
+	OutputDir string // 결과 파일 디렉토리 (기본값: output)
+
+	// 중복 인덱스 (nil이면 중복 확인 안 함)
+	// 이전 실행에서 수집한 게시글은 결과에서 빼고, 유사 중복 게시글은 "duplicate_cluster"로 묶음
+	Dedup *dedup.Index
+
+	// 개인정보 가리기 (nil이면 원문 그대로 저장)
+	Redact *redact.Redactor
+
+	// 분석용 본문 처리 (nil이면 처리 안 함)
+	// 본문 HTML에서 뽑은 텍스트의 문장/토큰/이모지를 "text_features"에 저장 (본문 HTML은 그대로)
+	Text *textproc.Options
+
+	// 임베딩용 청크 (nil이면 저장 안 함)
+	// 지정하면 전체 결과와 함께 cafe_{카페ID}_board_{게시판ID}_{시각}_chunks.jsonl 저장
+	Chunks *chunk.Options
+
+	// 페이지 처리가 끝날 때마다 호출 (진행 상황/중간 결과 전달용, 저장 고루틴 하나에서만 호출됨)
+	OnPage func(page, totalPages int, posts []map[string]interface{})
+}
+
+func (o CafeCrawlOptions) stages() postStages {
+	return postStages{index: o.Dedup, redactor: o.Redact, text: o.Text}
+}
+
+const defaultCafeWorkers = 3
+
+// 상세 정보를 가져올 게시글 (목록 페이지와 페이지 내 순서 포함)
+type articleJob struct {
+	page      int
+	index     int
+	pageTotal int // 같은 페이지의 게시글 수
+	post      map[string]interface{}
+}
+
 // 게시판 크롤링
-func CrawlBoard(cafeId, boardID string, cookie string, maxPages int, pageSize int) ([]map[string]interface{}, error) {
-	// 첫 페이지를 가져와서 마지막 페이지 번호 확인
-	log.Printf("📥 첫 페이지 로딩 중...")
-	firstPagePosts, lastPage, err := getPostList(cafeId, boardID, 1, pageSize, cookie)
-	if err != nil {
-		return nil, fmt.Errorf("첫 페이지 로드 실패: %v", err)
+// 목록 작업자가 게시글을 채널에 넣으면 상세 작업자들이 가져가 처리하고,
+// 결과는 하나의 저장 고루틴이 모아 페이지/전체 파일로 기록
+func CrawlBoard(cafeId, boardID string, session *Session, opts CafeCrawlOptions) ([]map[string]interface{}, error) {
+	if opts.ListWorkers <= 0 {
+		opts.ListWorkers = defaultCafeWorkers
+	}
+	if opts.DetailWorkers <= 0 {
+		opts.DetailWorkers = defaultCafeWorkers
 	}
-	log.Printf("✅ 첫 페이지 로드 완료 (%d개 게시글 발견)", len(firstPagePosts))
 
-	// 크롤링할 페이지 수 결정
-	pagesToCrawl := lastPage
-	if maxPages > 0 && maxPages < lastPage {
-		pagesToCrawl = maxPages
+	pager := newBoardPager(cafeId, boardID, opts.PageSize, session)
+	startPage := opts.StartPage
+	if startPage <= 0 {
+		startPage = 1
 	}
 
-	log.Printf("🚀 총 %d 페이지 중 %d 페이지 크롤링 시작 (페이지당 %d개 게시글, 동시 처리 3페이지)",
-		lastPage, pagesToCrawl, pageSize)
+	// 시작 페이지를 가져와서 마지막 페이지 번호 확인
+	log.Printf("📥 %d페이지 로딩 중...", startPage)
+	info, err := pager.fetch(startPage)
+	if err != nil {
+		return nil, fmt.Errorf("첫 페이지 로드 실패: %w", err)
+	}
 
-	var allPosts []map[string]interface{}
-	var mu sync.Mutex
+	// 필요한 페이지까지만 블록을 따라가며 마지막 페이지 확인
+	upTo := opts.EndPage
+	if opts.MaxPages > 0 && (upTo <= 0 || startPage+opts.MaxPages-1 < upTo) {
+		upTo = startPage + opts.MaxPages - 1
+	}
+	lastPage, exact, err := pager.lastPage(info, upTo)
+	if err != nil {
+		return nil, err
+	}
+	from, to := pageRange(startPage, opts.EndPage, opts.MaxPages, lastPage)
 
-	// 첫 페이지 결과에 상세 정보 추가
-	log.Printf("📝 첫 페이지 게시글 상세 정보 수집 중...")
-	for i, post := range firstPagePosts {
-		articleId := post["id"].(int)
-		log.Printf("  - 게시글 %d/%d 처리 중...", i+1, len(firstPagePosts))
-		detail, err := getArticleDetail(cafeId, articleId, cookie)
-		if err != nil {
-			log.Printf("⚠️ 게시글 %d 상세 정보 가져오기 실패: %v", articleId, err)
-			continue
-		}
-		firstPagePosts[i]["content"] = detail["content_html"]
-		firstPagePosts[i]["comments"] = detail["comments"]
-		log.Printf("  ✅ 게시글 %d 처리 완료 (댓글 %d개)", articleId, len(detail["comments"].([]map[string]interface{})))
+	if exact {
+		log.Printf("📚 게시판 전체 %d 페이지", lastPage)
+	} else {
+		log.Printf("📚 게시판 %d 페이지 이상", lastPage)
 	}
-	allPosts = append(allPosts, firstPagePosts...)
-	log.Printf("✅ 첫 페이지 상세 정보 수집 완료")
+	if from > to {
+		log.Printf("⚠️ 시작 페이지 %d가 마지막 페이지 %d보다 큽니다", from, lastPage)
+	}
+
+	log.Printf("🚀 %d~%d 페이지 크롤링 시작 (페이지당 %d개 게시글, 목록 작업자 %d개, 상세 작업자 %d개)",
+		from, to, opts.PageSize, opts.ListWorkers, opts.DetailWorkers)
 
-	// 첫 페이지 결과를 즉시 저장
 	timestamp := time.Now().Format("20060102_150405")
-	outputDir := "output"
+	outputDir := opts.OutputDir
+	if outputDir == "" {
+		outputDir = "output"
+	}
 	if err := os.MkdirAll(outputDir, 0755); err != nil {
 		log.Printf("⚠️ 출력 디렉토리 생성 실패: %v", err)
-	} else {
-		// 첫 페이지 전체 결과 저장
-		fullFilename := filepath.Join(outputDir, fmt.Sprintf("cafe_%s_board_%s_%s_full.json",
-			cafeId, boardID, timestamp))
-		if err := saveToJSON(firstPagePosts, fullFilename); err != nil {
-			log.Printf("⚠️ 첫 페이지 전체 결과 저장 실패: %v", err)
-		} else {
-			log.Printf("💾 첫 페이지 전체 결과가 %s 파일로 저장되었습니다.", fullFilename)
-		}
-
-		// 첫 페이지 개별 파일 저장
-		pageFilename := filepath.Join(outputDir, fmt.Sprintf("cafe_%s_board_%s_%s_page_1.json",
-			cafeId, boardID, timestamp))
-		if err := saveToJSON(firstPagePosts, pageFilename); err != nil {
-			log.Printf("⚠️ 첫 페이지 결과 저장 실패: %v", err)
-		} else {
-			log.Printf("💾 첫 페이지 결과가 %s 파일로 저장되었습니다.", pageFilename)
-		}
 	}
 
-	// 컨텍스트와 에러그룹 생성
-	ctx := context.Background()
-	eg, ctx := errgroup.WithContext(ctx)
-	eg.SetLimit(3) // 동시 처리 제한
+	eg, ctx := errgroup.WithContext(context.Background())
+	jobs := make(chan articleJob, opts.PageSize*opts.ListWorkers)
+	results := make(chan articleJob, opts.DetailWorkers)
 
-	// 2페이지부터 지정된 페이지까지 크롤링
-	for page := 12; page <= pagesToCrawl; page++ {
-		page := page
-		eg.Go(func() error {
+	// 목록 작업자: 페이지의 게시글을 jobs 채널로 전달
+	// 전달할 게시글이 없는 페이지는 저장 고루틴이 페이지를 끝낼 수 있도록 빈 작업을 보냄
+	// (results에는 상세 작업자만 보내야 작업자가 모두 끝난 뒤 닫아도 안전함)
+	enqueue := func(page int, posts []map[string]interface{}) error {
+		if len(posts) == 0 {
 			select {
+			case jobs <- articleJob{page: page}:
+				return nil
 			case <-ctx.Done():
 				return ctx.Err()
-			default:
+			}
+		}
+		for i, post := range posts {
+			select {
+			case jobs <- articleJob{page: page, index: i, pageTotal: len(posts), post: post}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	}
+
+	eg.Go(func() error {
+		defer close(jobs)
+
+		pagers, pagerCtx := errgroup.WithContext(ctx)
+		pagers.SetLimit(opts.ListWorkers)
+
+		var stop pageStop
+		for page := from; page <= to && !stop.after(page); page++ {
+			page := page
+			pagers.Go(func() error {
+				if pagerCtx.Err() != nil {
+					return pagerCtx.Err()
+				}
+				if stop.after(page) {
+					return nil
+				}
 				log.Printf("📥 %d페이지 로딩 중...", page)
-				posts, _, err := getPostList(cafeId, boardID, page, pageSize, cookie)
+				posts, err := pager.posts(page)
 				if err != nil {
-					return fmt.Errorf("페이지 %d 크롤링 실패: %v", page, err)
+					return fmt.Errorf("페이지 %d 크롤링 실패: %w", page, err)
 				}
 				log.Printf("✅ %d페이지 로드 완료 (%d개 게시글 발견)", page, len(posts))
+				// 이전 실행에서 수집한 게시글은 상세 정보를 다시 요청하지 않음
+				unknown := skipKnownCafePosts(opts.Dedup, cafeId, posts)
+				if len(posts) > 0 && len(unknown) == 0 {
+					stop.set(page)
+				}
+				return enqueue(page, unknown)
+			})
+		}
+		return pagers.Wait()
+	})
 
-				// 각 게시글의 상세 정보 가져오기
-				log.Printf("📝 %d페이지 게시글 상세 정보 수집 중...", page)
-				for i, post := range posts {
-					articleId := post["id"].(int)
-					log.Printf("  - %d페이지 게시글 %d/%d 처리 중...", page, i+1, len(posts))
-					detail, err := getArticleDetail(cafeId, articleId, cookie)
-					if err != nil {
-						log.Printf("⚠️ 게시글 %d 상세 정보 가져오기 실패: %v", articleId, err)
+	// 상세 작업자: jobs 채널의 게시글 상세 정보를 가져와 results 채널로 전달
+	var detailWorkers sync.WaitGroup
+	for w := 0; w < opts.DetailWorkers; w++ {
+		detailWorkers.Add(1)
+		eg.Go(func() error {
+			defer detailWorkers.Done()
+			for job := range jobs {
+				if job.post == nil {
+					select {
+					case results <- job:
 						continue
+					case <-ctx.Done():
+						return ctx.Err()
 					}
-					posts[i]["content"] = detail["content_html"]
-					posts[i]["comments"] = detail["comments"]
-					log.Printf("  ✅ %d페이지 게시글 %d 처리 완료 (댓글 %d개)",
-						page, articleId, len(detail["comments"].([]map[string]interface{})))
 				}
+				articleId := job.post["id"].(int)
+				log.Printf("  - %d페이지 게시글 %d/%d 처리 중...", job.page, job.index+1, job.pageTotal)
 
-				mu.Lock()
-				allPosts = append(allPosts, posts...)
-				mu.Unlock()
+				err := attachArticleDetail(cafeId, job.post, session)
+				if errors.Is(err, ErrSessionExpired) {
+					return err
+				}
+				switch {
+				case err != nil:
+					log.Printf("⚠️ 게시글 %d 상세 정보 가져오기 실패: %v", articleId, err)
+				case job.post["status"] != ArticleOK:
+					log.Printf("  🚫 %d페이지 게시글 %d 접근 불가 (%s: %s)",
+						job.page, articleId, job.post["status"], job.post["status_reason"])
+				default:
+					log.Printf("  ✅ %d페이지 게시글 %d 처리 완료 (댓글 %d개)",
+						job.page, articleId, len(job.post["comments"].([]map[string]interface{})))
+				}
 
-				// 페이지 결과를 즉시 저장
-				pageFilename := filepath.Join(outputDir, fmt.Sprintf("cafe_%s_board_%s_%s_page_%d.json",
-					cafeId, boardID, timestamp, page))
-				if err := saveToJSON(posts, pageFilename); err != nil {
-					log.Printf("⚠️ %d페이지 결과 저장 실패: %v", page, err)
-				} else {
-					log.Printf("💾 %d페이지 결과가 %s 파일로 저장되었습니다.", page, pageFilename)
+				select {
+				case results <- job:
+				case <-ctx.Done():
+					return ctx.Err()
 				}
+			}
+			return nil
+		})
+	}
+	go func() {
+		detailWorkers.Wait()
+		close(results)
+	}()
+
+	// 저장 고루틴: 결과를 모으고 페이지가 끝날 때마다 저장
+	var allJobs []articleJob
+	sinkDone := make(chan struct{})
+	go func() {
+		defer close(sinkDone)
+
+		pages := make(map[int][]articleJob)
+		for job := range results {
+			if job.post != nil {
+				pages[job.page] = append(pages[job.page], job)
+			}
+			if len(pages[job.page]) < job.pageTotal {
+				continue
+			}
+
+			// 완료된 페이지를 게시판 순서대로 후처리
+			pageJobs := pages[job.page]
+			sort.Slice(pageJobs, func(i, j int) bool { return pageJobs[i].index < pageJobs[j].index })
+			pageJobs = opts.stages().cafe(cafeId, pageJobs)
+			delete(pages, job.page)
+
+			allJobs = append(allJobs, pageJobs...)
+			pagePosts := jobPosts(pageJobs)
+			if opts.OnPage != nil {
+				opts.OnPage(job.page, to-from+1, pagePosts)
+			}
 
-				// 전체 결과 업데이트
-				fullFilename := filepath.Join(outputDir, fmt.Sprintf("cafe_%s_board_%s_%s_full.json",
-					cafeId, boardID, timestamp))
-				if err := saveToJSON(allPosts, fullFilename); err != nil {
-					log.Printf("⚠️ 전체 결과 업데이트 실패: %v", err)
+			if len(pagePosts) > 0 {
+				pageFilename := filepath.Join(outputDir, fmt.Sprintf("cafe_%s_board_%s_%s_page_%d.json",
+					cafeId, boardID, timestamp, job.page))
+				if err := saveToJSON(pagePosts, pageFilename); err != nil {
+					log.Printf("⚠️ %d페이지 결과 저장 실패: %v", job.page, err)
 				} else {
-					log.Printf("💾 전체 결과가 업데이트되었습니다. (현재 %d개 게시글)", len(allPosts))
+					log.Printf("💾 %d페이지 결과가 %s 파일로 저장되었습니다.", job.page, pageFilename)
 				}
+			}
 
-				log.Printf("✅ %d/%d 페이지 크롤링 완료 (누적 %d개 게시글)",
-					page, pagesToCrawl, len(allPosts))
-				return nil
+			fullFilename := filepath.Join(outputDir, fmt.Sprintf("cafe_%s_board_%s_%s_full.json",
+				cafeId, boardID, timestamp))
+			if err := saveToJSON(jobPosts(allJobs), fullFilename); err != nil {
+				log.Printf("⚠️ 전체 결과 업데이트 실패: %v", err)
+			} else {
+				log.Printf("💾 전체 결과가 업데이트되었습니다. (현재 %d개 게시글)", len(allJobs))
 			}
-		})
-	}
+
+			log.Printf("✅ %d/%d 페이지 크롤링 완료 (누적 %d개 게시글)", job.page, to, len(allJobs))
+		}
+	}()
 
 	err = eg.Wait()
+	<-sinkDone
 	if err != nil {
 		return nil, err
 	}
 
+	allPosts := jobPosts(allJobs)
 	log.Printf("🎉 크롤링 완료! 총 %d개 게시글 수집", len(allPosts))
+	if opts.Chunks != nil && len(allPosts) > 0 {
+		chunkFilename := filepath.Join(outputDir, fmt.Sprintf("cafe_%s_board_%s_%s_chunks.jsonl", cafeId, boardID, timestamp))
+		if err := saveCafeChunks(cafeId, allPosts, *opts.Chunks, chunkFilename); err != nil {
+			log.Printf("⚠️ %v", err)
+		}
+	}
+	logStatusSummary(allPosts)
 	return allPosts, nil
 }
 
+// 처리된 게시글을 페이지, 페이지 내 순서대로 정렬해 반환
+func jobPosts(jobs []articleJob) []map[string]interface{} {
+	sorted := make([]articleJob, len(jobs))
+	copy(sorted, jobs)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].page != sorted[j].page {
+			return sorted[i].page < sorted[j].page
+		}
+		return sorted[i].index < sorted[j].index
+	})
+
+	posts := make([]map[string]interface{}, len(sorted))
+	for i, job := range sorted {
+		posts[i] = job.post
+	}
+	return posts
+}
+
 // JSON 저장 함수
 func saveToJSON(data interface{}, filename string) error {
 	jsonData, err := json.MarshalIndent(data, "", "  ")
@@ -0,0 +1,322 @@
+package crawling
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"naverCrawler/internal/chunk"
+	"naverCrawler/internal/redact"
+	"naverCrawler/internal/textproc"
+)
+
+// CafeMember 크롤링 대상 카페 회원 (MemberKey 또는 NickName 중 하나는 필수)
+type CafeMember struct {
+	MemberKey string
+	NickName  string
+}
+
+// CafeMemberResult 회원 단위 크롤링 결과
+type CafeMemberResult struct {
+	MemberKey string                   `json:"member_key"`
+	NickName  string                   `json:"nickname"`
+	Articles  []map[string]interface{} `json:"articles"`
+	Comments  []map[string]interface{} `json:"comments"`
+}
+
+// CafeMemberOptions 회원 크롤링 옵션
+type CafeMemberOptions struct {
+	MaxPages int // 작성글/작성댓글 목록의 최대 페이지 수 (0은 무제한)
+
+	// 개인정보 가리기 (nil이면 원문 그대로 저장)
+	// 회원 닉네임/키도 가명으로 바꾸므로 결과 파일 이름에도 가명이 들어감
+	Redact *redact.Redactor
+
+	// 작성글 본문 분석용 처리 (nil이면 처리 안 함, CafeCrawlOptions.Text와 같음)
+	Text *textproc.Options
+
+	// 작성글 임베딩용 청크 (nil이면 저장 안 함)
+	// 지정하면 결과와 함께 cafe_{카페ID}_member_{회원 키}_{시각}_chunks.jsonl 저장
+	Chunks *chunk.Options
+}
+
+// 회원 작성글 목록 응답 구조체
+type MemberArticleListResponse struct {
+	Message struct {
+		Status string `json:"status"`
+		Error  struct {
+			Code string `json:"code"`
+			Msg  string `json:"msg"`
+		} `json:"error"`
+		Result struct {
+			ArticleList []struct {
+				ArticleId          int    `json:"articleid"`
+				MenuId             int    `json:"menuid"`
+				MenuName           string `json:"menuname"`
+				Subject            string `json:"subject"`
+				WriteDateTimestamp int64  `json:"writedt"`
+				CommentCount       int    `json:"commentcount"`
+				ReadCount          int    `json:"readcount"`
+			} `json:"articleList"`
+			HasNext bool `json:"hasNext"`
+		} `json:"result"`
+	} `json:"message"`
+}
+
+// 회원 작성댓글 목록 응답 구조체
+type MemberCommentListResponse struct {
+	Message struct {
+		Status string `json:"status"`
+		Error  struct {
+			Code string `json:"code"`
+			Msg  string `json:"msg"`
+		} `json:"error"`
+		Result struct {
+			CommentList []struct {
+				ArticleId          int    `json:"articleid"`
+				CommentId          int    `json:"commentid"`
+				Subject            string `json:"subject"`
+				Content            string `json:"content"`
+				WriteDateTimestamp int64  `json:"writedt"`
+			} `json:"commentList"`
+			HasNext bool `json:"hasNext"`
+		} `json:"result"`
+	} `json:"message"`
+}
+
+// 회원 네트워크 API 공통 파라미터
+func memberNetworkURL(api, cafeId, memberKey string, page, perPage int) string {
+	params := url.Values{}
+	params.Set("search.cafeId", cafeId)
+	params.Set("search.memberKey", memberKey)
+	params.Set("search.page", strconv.Itoa(page))
+	params.Set("search.perPage", strconv.Itoa(perPage))
+	params.Set("requestFrom", "A")
+	return endpoints.CafeAPI + "/cafe-web/cafe-mobile/" + api + "?" + params.Encode()
+}
+
+// 회원 작성글 목록 가져오기
+func getMemberArticleList(cafeId, memberKey string, page, perPage int, session *Session) ([]map[string]interface{}, bool, error) {
+	resp, err := getAPIResponse(memberNetworkURL("CafeMemberNetworkArticleListV1", cafeId, memberKey, page, perPage), session)
+	if err != nil {
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+
+	var result MemberArticleListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, false, err
+	}
+	if result.Message.Status != "" && result.Message.Status != "200" {
+		return nil, false, fmt.Errorf("작성글 API 오류: %s %s", result.Message.Error.Code, result.Message.Error.Msg)
+	}
+
+	var posts []map[string]interface{}
+	for _, article := range result.Message.Result.ArticleList {
+		posts = append(posts, map[string]interface{}{
+			"id":            article.ArticleId,
+			"title":         article.Subject,
+			"menu_id":       article.MenuId,
+			"menu_name":     article.MenuName,
+			"member_key":    memberKey,
+			"write_date":    time.Unix(article.WriteDateTimestamp/1000, 0).Format("2006-01-02 15:04:05"),
+			"comment_count": article.CommentCount,
+			"read_count":    article.ReadCount,
+		})
+	}
+	return posts, result.Message.Result.HasNext, nil
+}
+
+// 회원 작성댓글 목록 가져오기
+func getMemberCommentList(cafeId, memberKey string, page, perPage int, session *Session) ([]map[string]interface{}, bool, error) {
+	resp, err := getAPIResponse(memberNetworkURL("CafeMemberNetworkReplyListV1", cafeId, memberKey, page, perPage), session)
+	if err != nil {
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+
+	var result MemberCommentListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, false, err
+	}
+	if result.Message.Status != "" && result.Message.Status != "200" {
+		return nil, false, fmt.Errorf("작성댓글 API 오류: %s %s", result.Message.Error.Code, result.Message.Error.Msg)
+	}
+
+	var comments []map[string]interface{}
+	for _, comment := range result.Message.Result.CommentList {
+		comments = append(comments, map[string]interface{}{
+			"id":            comment.CommentId,
+			"article_id":    comment.ArticleId,
+			"article_title": comment.Subject,
+			"content":       comment.Content,
+			"member_key":    memberKey,
+			"write_date":    time.Unix(comment.WriteDateTimestamp/1000, 0).Format("2006-01-02 15:04:05"),
+		})
+	}
+	return comments, result.Message.Result.HasNext, nil
+}
+
+// 닉네임으로 회원 키 찾기 (작성자 검색 결과에서 닉네임이 정확히 일치하는 회원)
+func findMemberKey(cafeId, nickName string, session *Session) (string, error) {
+	opts := CafeSearchOptions{Query: nickName, SearchBy: SearchByWriter, PageSize: 20}
+	posts, _, err := searchArticles(cafeId, opts, 1, session)
+	if err != nil {
+		return "", err
+	}
+	for _, post := range posts {
+		if post["writer"] == nickName {
+			if memberKey, _ := post["member_key"].(string); memberKey != "" {
+				return memberKey, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("닉네임 '%s'의 회원을 찾을 수 없습니다", nickName)
+}
+
+// 특정 회원의 작성글/댓글 크롤링
+func CrawlMember(cafeId string, member CafeMember, session *Session, opts CafeMemberOptions) (*CafeMemberResult, error) {
+	const perPage = 20
+	maxPages := opts.MaxPages
+
+	memberKey := member.MemberKey
+	if memberKey == "" {
+		if member.NickName == "" {
+			return nil, fmt.Errorf("회원 키 또는 닉네임이 필요합니다")
+		}
+		log.Printf("🔎 닉네임 '%s'의 회원 키 조회 중...", member.NickName)
+		key, err := findMemberKey(cafeId, member.NickName, session)
+		if err != nil {
+			return nil, fmt.Errorf("회원 조회 실패: %w", err)
+		}
+		memberKey = key
+	}
+
+	log.Printf("👤 카페 %s 회원 %s 크롤링 시작...", cafeId, memberKey)
+	result := &CafeMemberResult{MemberKey: memberKey, NickName: member.NickName}
+
+	// 작성글
+	for page := 1; maxPages <= 0 || page <= maxPages; page++ {
+		log.Printf("📥 작성글 %d페이지 로딩 중...", page)
+		posts, hasNext, err := getMemberArticleList(cafeId, memberKey, page, perPage, session)
+		if err != nil {
+			if page == 1 || errors.Is(err, ErrSessionExpired) {
+				return nil, fmt.Errorf("작성글 목록 로드 실패: %w", err)
+			}
+			log.Printf("⚠️ 작성글 %d페이지 로드 실패: %v", page, err)
+			break
+		}
+
+		for i, post := range posts {
+			log.Printf("  - 작성글 %d페이지 게시글 %d/%d 처리 중...", page, i+1, len(posts))
+			if err := attachArticleDetail(cafeId, post, session); err != nil {
+				if errors.Is(err, ErrSessionExpired) {
+					return nil, err
+				}
+				log.Printf("⚠️ 게시글 %d 상세 정보 가져오기 실패: %v", post["id"], err)
+				continue
+			}
+			if result.NickName == "" {
+				result.NickName, _ = post["writer"].(string)
+			}
+		}
+		result.Articles = append(result.Articles, posts...)
+
+		if !hasNext {
+			break
+		}
+	}
+
+	// 작성댓글 - 댓글이 달린 게시글을 가져와 해당 회원의 댓글 전체 내용으로 채움
+	details := make(map[int]map[string]interface{})
+	for page := 1; maxPages <= 0 || page <= maxPages; page++ {
+		log.Printf("📥 작성댓글 %d페이지 로딩 중...", page)
+		comments, hasNext, err := getMemberCommentList(cafeId, memberKey, page, perPage, session)
+		if errors.Is(err, ErrSessionExpired) {
+			return nil, fmt.Errorf("작성댓글 목록 로드 실패: %w", err)
+		}
+		if err != nil {
+			log.Printf("⚠️ 작성댓글 %d페이지 로드 실패: %v", page, err)
+			break
+		}
+
+		for _, comment := range comments {
+			articleId := comment["article_id"].(int)
+			detail, ok := details[articleId]
+			if !ok {
+				detail, err = getArticleDetail(cafeId, articleId, session)
+				if errors.Is(err, ErrSessionExpired) {
+					return nil, err
+				}
+				if err != nil {
+					log.Printf("⚠️ 게시글 %d 상세 정보 가져오기 실패: %v", articleId, err)
+					detail = nil
+				}
+				details[articleId] = detail
+			}
+			if detail == nil {
+				continue
+			}
+			if detail["status"] != ArticleOK {
+				// 게시글에 접근할 수 없으면 목록 API의 댓글 내용만 남김
+				comment["article_status"] = detail["status"]
+				continue
+			}
+
+			comment["article_title"] = detail["title"]
+			comment["article_writer"] = detail["writer"]
+			for _, c := range detail["comments"].([]map[string]interface{}) {
+				if c["id"] == comment["id"] {
+					comment["content"] = c["content"]
+					comment["like_count"] = c["like_count"]
+					if result.NickName == "" {
+						result.NickName, _ = c["writer"].(string)
+					}
+					break
+				}
+			}
+		}
+		result.Comments = append(result.Comments, comments...)
+
+		if !hasNext {
+			break
+		}
+	}
+
+	// 게시판/검색과 같은 순서로 가린 뒤 분석용 본문 처리
+	redactMemberResult(opts.Redact, result)
+	jobs := make([]articleJob, len(result.Articles))
+	for i, article := range result.Articles {
+		jobs[i] = articleJob{index: i, post: article}
+	}
+	processCafeText(opts.Text, jobs)
+
+	outputDir := "output"
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		log.Printf("⚠️ 출력 디렉토리 생성 실패: %v", err)
+	} else {
+		timestamp := time.Now().Format("20060102_150405")
+		filename := filepath.Join(outputDir, fmt.Sprintf("cafe_%s_member_%s_%s.json", cafeId, result.MemberKey, timestamp))
+		if err := saveToJSON(result, filename); err != nil {
+			log.Printf("⚠️ 회원 크롤링 결과 저장 실패: %v", err)
+		} else {
+			log.Printf("💾 회원 크롤링 결과가 %s 파일로 저장되었습니다.", filename)
+		}
+		if opts.Chunks != nil && len(result.Articles) > 0 {
+			chunkFilename := filepath.Join(outputDir, fmt.Sprintf("cafe_%s_member_%s_%s_chunks.jsonl", cafeId, result.MemberKey, timestamp))
+			if err := saveCafeChunks(cafeId, result.Articles, *opts.Chunks, chunkFilename); err != nil {
+				log.Printf("⚠️ %v", err)
+			}
+		}
+	}
+
+	log.Printf("🎉 회원 크롤링 완료! 작성글 %d개, 댓글 %d개 수집", len(result.Articles), len(result.Comments))
+	logStatusSummary(result.Articles)
+	return result, nil
+}
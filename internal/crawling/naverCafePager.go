@@ -0,0 +1,112 @@
+package crawling
+
+import (
+	"fmt"
+	"log"
+	"sync"
+)
+
+// CafePageInfo 게시판 목록 응답의 페이지 정보
+// 네이버 카페는 페이지 번호를 10개씩 묶어(네비게이션 블록) 보여주므로
+// LastNavigationPageNumber는 전체 마지막 페이지가 아니라 현재 블록의 마지막 페이지
+type CafePageInfo struct {
+	LastNavigationPageNumber int  `json:"lastNavigationPageNumber"`
+	VisibleNextButton        bool `json:"visibleNextButton"` // 다음 블록이 있는지 여부
+}
+
+// 게시판 목록 페이지를 가져오고, 마지막 페이지를 찾는 동안 받은 페이지는 다시 요청하지 않도록 보관
+type boardPager struct {
+	cafeId   string
+	boardID  string
+	pageSize int
+	session  *Session
+
+	mu    sync.Mutex
+	cache map[int][]map[string]interface{}
+}
+
+func newBoardPager(cafeId, boardID string, pageSize int, session *Session) *boardPager {
+	return &boardPager{
+		cafeId:   cafeId,
+		boardID:  boardID,
+		pageSize: pageSize,
+		session:  session,
+		cache:    make(map[int][]map[string]interface{}),
+	}
+}
+
+// 페이지 게시글 목록 (보관된 페이지는 한 번만 꺼내 쓰고 버림)
+func (p *boardPager) posts(page int) ([]map[string]interface{}, error) {
+	p.mu.Lock()
+	posts, ok := p.cache[page]
+	delete(p.cache, page)
+	p.mu.Unlock()
+	if ok {
+		return posts, nil
+	}
+
+	posts, _, err := getPostList(p.cafeId, p.boardID, page, p.pageSize, p.session)
+	return posts, err
+}
+
+// 페이지를 가져와 보관하고 페이지 정보 반환
+func (p *boardPager) fetch(page int) (CafePageInfo, error) {
+	posts, info, err := getPostList(p.cafeId, p.boardID, page, p.pageSize, p.session)
+	if err != nil {
+		return info, err
+	}
+
+	p.mu.Lock()
+	p.cache[page] = posts
+	p.mu.Unlock()
+	return info, nil
+}
+
+// 다음 블록 버튼을 따라가며 마지막 페이지 찾기
+// upTo가 0보다 크면 마지막 페이지가 upTo 이상인 것이 확인되는 즉시 멈추고 false(정확한 끝이 아님)를 반환
+func (p *boardPager) lastPage(info CafePageInfo, upTo int) (int, bool, error) {
+	for info.VisibleNextButton {
+		if upTo > 0 && info.LastNavigationPageNumber >= upTo {
+			return info.LastNavigationPageNumber, false, nil
+		}
+
+		next := info.LastNavigationPageNumber + 1
+		log.Printf("🔎 다음 페이지 블록 확인 중... (%d페이지)", next)
+		nextInfo, err := p.fetch(next)
+		if err != nil {
+			return 0, false, fmt.Errorf("%d페이지 확인 실패: %w", next, err)
+		}
+		if nextInfo.LastNavigationPageNumber <= info.LastNavigationPageNumber {
+			// 블록이 더 이상 넘어가지 않으면 무한 반복 방지
+			break
+		}
+		info = nextInfo
+	}
+	return info.LastNavigationPageNumber, true, nil
+}
+
+// CountBoardPages 게시판의 전체 페이지 수
+func CountBoardPages(cafeId, boardID string, pageSize int, session *Session) (int, error) {
+	pager := newBoardPager(cafeId, boardID, pageSize, session)
+	info, err := pager.fetch(1)
+	if err != nil {
+		return 0, fmt.Errorf("첫 페이지 로드 실패: %w", err)
+	}
+	last, _, err := pager.lastPage(info, 0)
+	return last, err
+}
+
+// 크롤링할 페이지 범위 계산
+// start/end는 1부터 시작하는 페이지 번호 (end 0은 마지막 페이지까지), maxPages는 최대 페이지 수 (0은 무제한)
+func pageRange(start, end, maxPages, lastPage int) (int, int) {
+	if start <= 0 {
+		start = 1
+	}
+	if end <= 0 || end > lastPage {
+		end = lastPage
+	}
+	if maxPages > 0 && end-start+1 > maxPages {
+		end = start + maxPages - 1
+	}
+	return start, end
+}
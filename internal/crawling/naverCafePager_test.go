@@ -0,0 +1,170 @@
+package crawling
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// 전체 totalPages 페이지, 10페이지 단위 블록인 게시판 (페이지마다 게시글 1개, ID는 1000+페이지)
+func fakeBoard(f *fakeNaver, totalPages int) {
+	f.handle(testBoardPath, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		blockEnd := (page + 9) / 10 * 10
+		articles := []map[string]interface{}{}
+		if page <= totalPages {
+			articles = append(articles, map[string]interface{}{
+				"type": "ARTICLE",
+				"item": map[string]interface{}{"articleId": 1000 + page, "subject": "글 " + strconv.Itoa(page)},
+			})
+		}
+		resp := map[string]interface{}{
+			"result": map[string]interface{}{
+				"articleList": articles,
+				"pageInfo": CafePageInfo{
+					LastNavigationPageNumber: min(blockEnd, totalPages),
+					VisibleNextButton:        blockEnd < totalPages,
+				},
+			},
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	f.route(testArticlePath("*"), nil, http.StatusOK, "cafe_article_1001.json")
+}
+
+func TestBoardPagerLastPage(t *testing.T) {
+	tests := []struct {
+		name         string
+		totalPages   int
+		upTo         int
+		wantLast     int
+		wantExact    bool
+		wantRequests int
+	}{
+		{"single block", 7, 0, 7, true, 1},
+		{"follows next buttons to the end", 23, 0, 23, true, 3},
+		{"exact multiple of block size", 20, 0, 20, true, 2},
+		{"stops once enough pages are known", 23, 5, 10, false, 1},
+		{"stops in a later block", 23, 15, 20, false, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newFakeNaver(t)
+			fakeBoard(fake, tt.totalPages)
+
+			pager := newBoardPager(testCafeID, testBoardID, 15, testSession())
+			info, err := pager.fetch(1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			last, exact, err := pager.lastPage(info, tt.upTo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if last != tt.wantLast || exact != tt.wantExact {
+				t.Errorf("lastPage = %d, %v; want %d, %v", last, exact, tt.wantLast, tt.wantExact)
+			}
+			if n := fake.count(testBoardPath); n != tt.wantRequests {
+				t.Errorf("list requests = %d, want %d", n, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestCountBoardPages(t *testing.T) {
+	fake := newFakeNaver(t)
+	fakeBoard(fake, 35)
+
+	pages, err := CountBoardPages(testCafeID, testBoardID, 15, testSession())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pages != 35 {
+		t.Errorf("CountBoardPages = %d, want 35", pages)
+	}
+}
+
+func TestPageRange(t *testing.T) {
+	tests := []struct {
+		start, end, maxPages, last int
+		wantFrom, wantTo           int
+	}{
+		{0, 0, 0, 23, 1, 23},
+		{1, 0, 10, 23, 1, 10},
+		{5, 8, 0, 23, 5, 8},
+		{5, 0, 3, 23, 5, 7},
+		{20, 30, 0, 23, 20, 23},
+		{25, 0, 0, 23, 25, 23}, // 시작 페이지가 끝보다 뒤면 빈 범위
+	}
+	for _, tt := range tests {
+		from, to := pageRange(tt.start, tt.end, tt.maxPages, tt.last)
+		if from != tt.wantFrom || to != tt.wantTo {
+			t.Errorf("pageRange(%d, %d, %d, %d) = %d, %d; want %d, %d",
+				tt.start, tt.end, tt.maxPages, tt.last, from, to, tt.wantFrom, tt.wantTo)
+		}
+	}
+}
+
+func TestCrawlBoardPageRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    CafeCrawlOptions
+		wantIDs []int
+	}{
+		{"whole board", CafeCrawlOptions{}, seq(1001, 1023)},
+		{"max pages", CafeCrawlOptions{MaxPages: 12}, seq(1001, 1012)},
+		{"start and end page", CafeCrawlOptions{StartPage: 9, EndPage: 12}, seq(1009, 1012)},
+		{"start page with max pages", CafeCrawlOptions{StartPage: 21, MaxPages: 10}, seq(1021, 1023)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Chdir(t.TempDir())
+
+			fake := newFakeNaver(t)
+			fakeBoard(fake, 23)
+
+			tt.opts.PageSize = 15
+			posts, err := CrawlBoard(testCafeID, testBoardID, testSession(), tt.opts)
+			if err != nil {
+				t.Fatalf("CrawlBoard: %v", err)
+			}
+
+			var ids []int
+			for _, post := range posts {
+				ids = append(ids, post["id"].(int))
+			}
+			if !equalInts(ids, tt.wantIDs) {
+				t.Errorf("ids = %v, want %v", ids, tt.wantIDs)
+			}
+
+			// 마지막 페이지 확인에 쓴 페이지는 다시 요청하지 않음
+			if n := fake.count(testBoardPath); n != len(tt.wantIDs) {
+				t.Errorf("list requests = %d for %d pages", n, len(tt.wantIDs))
+			}
+		})
+	}
+}
+
+func seq(from, to int) []int {
+	var s []int
+	for i := from; i <= to; i++ {
+		s = append(s, i)
+	}
+	return s
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,270 @@
+package crawling
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"naverCrawler/internal/chunk"
+	"naverCrawler/internal/dedup"
+	"naverCrawler/internal/redact"
+	"naverCrawler/internal/textproc"
+)
+
+// 카페 검색 대상 (CafeMobileWebArticleSearchList의 searchBy 값)
+const (
+	SearchByAll     = 0 // 제목+내용
+	SearchByTitle   = 1 // 제목만
+	SearchByWriter  = 3 // 글작성자
+	SearchByComment = 4 // 댓글내용
+)
+
+// CafeSearchOptions 카페 내 게시글 검색 조건
+type CafeSearchOptions struct {
+	Query     string    // 검색어 (SearchByWriter인 경우 작성자 닉네임)
+	SearchBy  int       // 검색 대상 (SearchByAll, SearchByTitle, ...)
+	MenuID    string    // 게시판 ID (비어있으면 전체 게시판)
+	StartDate time.Time // 작성일 시작 (zero value면 제한 없음)
+	EndDate   time.Time // 작성일 끝 (zero value면 제한 없음)
+	PageSize  int       // 페이지당 게시글 수 (기본값: 20)
+	MaxPages  int       // 최대 페이지 수 (0은 무제한)
+	OutputDir string    // 결과 파일 디렉토리 (기본값: output)
+
+	// 중복 인덱스 (nil이면 중복 확인 안 함)
+	// 이전 실행에서 수집한 게시글은 결과에서 빼고, 유사 중복 게시글은 "duplicate_cluster"로 묶음
+	Dedup *dedup.Index
+
+	// 개인정보 가리기 (nil이면 원문 그대로 저장)
+	Redact *redact.Redactor
+
+	// 분석용 본문 처리 (nil이면 처리 안 함, CafeCrawlOptions.Text와 같음)
+	Text *textproc.Options
+
+	// 임베딩용 청크 (nil이면 저장 안 함)
+	// 지정하면 전체 결과와 함께 cafe_{카페ID}_search_{시각}_chunks.jsonl 저장
+	Chunks *chunk.Options
+}
+
+func (o CafeSearchOptions) stages() postStages {
+	return postStages{index: o.Dedup, redactor: o.Redact, text: o.Text}
+}
+
+// 검색 응답 구조체
+type ArticleSearchResponse struct {
+	Message struct {
+		Status string `json:"status"`
+		Error  struct {
+			Code string `json:"code"`
+			Msg  string `json:"msg"`
+		} `json:"error"`
+		Result struct {
+			TotalCount  int `json:"totalCount"`
+			ArticleList []struct {
+				Type string `json:"type"`
+				Item struct {
+					ArticleId          int    `json:"articleId"`
+					MenuId             int    `json:"menuId"`
+					MenuName           string `json:"menuName"`
+					Subject            string `json:"subject"`
+					NickName           string `json:"nickname"`
+					MemberKey          string `json:"memberKey"`
+					WriteDateTimestamp int64  `json:"writeDateTimestamp"`
+					CommentCount       int    `json:"commentCount"`
+					ReadCount          int    `json:"readCount"`
+					LikeItCount        int    `json:"likeItCount"`
+				} `json:"item"`
+			} `json:"articleList"`
+		} `json:"result"`
+	} `json:"message"`
+}
+
+// 검색 결과 목록 가져오기 (더 가져올 페이지가 있는지 여부도 반환)
+func searchArticles(cafeId string, opts CafeSearchOptions, page int, session *Session) ([]map[string]interface{}, bool, error) {
+	params := url.Values{}
+	params.Set("cafeId", cafeId)
+	params.Set("query", opts.Query)
+	params.Set("searchBy", strconv.Itoa(opts.SearchBy))
+	params.Set("sortBy", "date")
+	params.Set("page", strconv.Itoa(page))
+	params.Set("perPage", strconv.Itoa(opts.PageSize))
+	params.Set("adUnit", "MW_CAFE_BOARD")
+	params.Set("ad", "false")
+	if opts.MenuID != "" {
+		params.Set("menuId", opts.MenuID)
+	}
+	if !opts.StartDate.IsZero() || !opts.EndDate.IsZero() {
+		// 기간 검색: "시작일종료일" 형식 (예: 2024-01-012024-03-31)
+		start, end := opts.StartDate, opts.EndDate
+		if start.IsZero() {
+			start = time.Date(2003, 1, 1, 0, 0, 0, 0, time.Local)
+		}
+		if end.IsZero() {
+			end = time.Now()
+		}
+		params.Set("searchdate", start.Format("2006-01-02")+end.Format("2006-01-02"))
+	}
+
+	apiURL := endpoints.CafeAPI + "/cafe-web/cafe-mobile/CafeMobileWebArticleSearchListV4?" + params.Encode()
+
+	resp, err := getAPIResponse(apiURL, session)
+	if err != nil {
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+
+	var result ArticleSearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, false, err
+	}
+
+	if result.Message.Status != "" && result.Message.Status != "200" {
+		return nil, false, fmt.Errorf("검색 API 오류: %s %s", result.Message.Error.Code, result.Message.Error.Msg)
+	}
+
+	var posts []map[string]interface{}
+	reachedStart := false
+	for _, article := range result.Message.Result.ArticleList {
+		if article.Type != "ARTICLE" {
+			continue
+		}
+
+		// API의 기간 검색과 별개로 작성일을 한 번 더 확인
+		writeDate := time.Unix(article.Item.WriteDateTimestamp/1000, 0)
+		if !opts.EndDate.IsZero() && writeDate.After(opts.EndDate) {
+			continue
+		}
+		if !opts.StartDate.IsZero() && writeDate.Before(opts.StartDate) {
+			// 최신순 정렬이므로 이후 결과는 모두 기간 밖
+			reachedStart = true
+			continue
+		}
+
+		posts = append(posts, map[string]interface{}{
+			"id":            article.Item.ArticleId,
+			"title":         article.Item.Subject,
+			"writer":        article.Item.NickName,
+			"member_key":    article.Item.MemberKey,
+			"menu_id":       article.Item.MenuId,
+			"menu_name":     article.Item.MenuName,
+			"write_date":    writeDate.Format("2006-01-02 15:04:05"),
+			"comment_count": article.Item.CommentCount,
+			"read_count":    article.Item.ReadCount,
+			"like_count":    article.Item.LikeItCount,
+		})
+	}
+
+	hasMore := !reachedStart &&
+		len(result.Message.Result.ArticleList) >= opts.PageSize &&
+		page*opts.PageSize < result.Message.Result.TotalCount
+
+	return posts, hasMore, nil
+}
+
+// 게시글 목록 항목에 본문과 댓글을 채워 넣기
+func attachArticleDetail(cafeId string, post map[string]interface{}, session *Session) error {
+	articleId := post["id"].(int)
+	detail, err := getArticleDetail(cafeId, articleId, session)
+	if err != nil {
+		return err
+	}
+	post["content"] = detail["content_html"]
+	post["comments"] = detail["comments"]
+	post["status"] = detail["status"]
+	if detail["status"] != ArticleOK {
+		post["status_reason"] = detail["status_reason"]
+		return nil
+	}
+
+	// 목록 API에 없는 작성자 정보는 상세 정보로 보완 (회원 작성글 목록에는 닉네임도 없음)
+	for _, key := range []string{"writer", "member_key", "writer_level", "is_staff", "is_manager"} {
+		if _, ok := post[key]; !ok {
+			post[key] = detail[key]
+		}
+	}
+	return nil
+}
+
+// 카페 내 검색 결과 크롤링
+func CrawlSearch(cafeId string, opts CafeSearchOptions, session *Session) ([]map[string]interface{}, error) {
+	if opts.Query == "" {
+		return nil, fmt.Errorf("검색어가 비어있습니다")
+	}
+	if opts.PageSize <= 0 {
+		opts.PageSize = 20
+	}
+
+	log.Printf("🔍 카페 %s 검색 시작 (검색어: %q, 게시판: %q)", cafeId, opts.Query, opts.MenuID)
+
+	var allPosts []map[string]interface{}
+	for page := 1; opts.MaxPages <= 0 || page <= opts.MaxPages; page++ {
+		log.Printf("📥 검색 결과 %d페이지 로딩 중...", page)
+		posts, hasMore, err := searchArticles(cafeId, opts, page, session)
+		if err != nil {
+			if page == 1 || errors.Is(err, ErrSessionExpired) {
+				return nil, fmt.Errorf("검색 결과 로드 실패: %w", err)
+			}
+			log.Printf("⚠️ 검색 결과 %d페이지 로드 실패: %v", page, err)
+			break
+		}
+		log.Printf("✅ 검색 결과 %d페이지 로드 완료 (%d개 게시글 발견)", page, len(posts))
+		// 이전 실행에서 수집한 게시글은 상세 정보를 다시 요청하지 않고,
+		// 페이지 전체가 이미 수집한 게시글이면 이후(더 오래된) 결과도 가져오지 않음
+		unknown := skipKnownCafePosts(opts.Dedup, cafeId, posts)
+		if len(posts) > 0 && len(unknown) == 0 {
+			log.Printf("♻️ 검색 결과 %d페이지의 게시글을 모두 이미 수집해 이후 페이지는 가져오지 않습니다", page)
+			break
+		}
+		posts = unknown
+
+		for i, post := range posts {
+			log.Printf("  - %d페이지 게시글 %d/%d 처리 중...", page, i+1, len(posts))
+			if err := attachArticleDetail(cafeId, post, session); err != nil {
+				if errors.Is(err, ErrSessionExpired) {
+					return nil, err
+				}
+				log.Printf("⚠️ 게시글 %d 상세 정보 가져오기 실패: %v", post["id"], err)
+			}
+		}
+		jobs := make([]articleJob, len(posts))
+		for i, post := range posts {
+			jobs[i] = articleJob{page: page, index: i, pageTotal: len(posts), post: post}
+		}
+		allPosts = append(allPosts, jobPosts(opts.stages().cafe(cafeId, jobs))...)
+
+		if !hasMore {
+			break
+		}
+	}
+
+	outputDir := opts.OutputDir
+	if outputDir == "" {
+		outputDir = "output"
+	}
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		log.Printf("⚠️ 출력 디렉토리 생성 실패: %v", err)
+	} else {
+		timestamp := time.Now().Format("20060102_150405")
+		filename := filepath.Join(outputDir, fmt.Sprintf("cafe_%s_search_%s_full.json", cafeId, timestamp))
+		if err := saveToJSON(allPosts, filename); err != nil {
+			log.Printf("⚠️ 검색 결과 저장 실패: %v", err)
+		} else {
+			log.Printf("💾 검색 결과가 %s 파일로 저장되었습니다.", filename)
+		}
+		if opts.Chunks != nil && len(allPosts) > 0 {
+			chunkFilename := filepath.Join(outputDir, fmt.Sprintf("cafe_%s_search_%s_chunks.jsonl", cafeId, timestamp))
+			if err := saveCafeChunks(cafeId, allPosts, *opts.Chunks, chunkFilename); err != nil {
+				log.Printf("⚠️ %v", err)
+			}
+		}
+	}
+
+	log.Printf("🎉 검색 크롤링 완료! 총 %d개 게시글 수집", len(allPosts))
+	logStatusSummary(allPosts)
+	return allPosts, nil
+}
@@ -0,0 +1,102 @@
+package crawling
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// ArticleStatus 게시글 접근 상태
+type ArticleStatus string
+
+const (
+	ArticleOK            ArticleStatus = "ok"             // 정상
+	ArticleDeleted       ArticleStatus = "deleted"        // 삭제되었거나 존재하지 않음
+	ArticleNoPermission  ArticleStatus = "no_permission"  // 멤버 공개 등 열람 권한 없음
+	ArticleLevelRequired ArticleStatus = "level_required" // 특정 등급 이상만 열람 가능
+	ArticleBlinded       ArticleStatus = "blinded"        // 블라인드 처리됨
+	ArticleUnknownError  ArticleStatus = "error"          // 상태를 알 수 없음 (상태가 기록되지 않은 게시글)
+)
+
+// APIError 200이 아닌 API 응답 (본문을 함께 보관해 원인 분석에 사용)
+type APIError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("HTTP 오류: %d", e.StatusCode)
+}
+
+// 게시글 상세 API의 오류 응답 구조체
+type articleErrorResponse struct {
+	Result struct {
+		ErrorCode string `json:"errorCode"`
+		Reason    string `json:"reason"`
+		Message   string `json:"message"`
+	} `json:"result"`
+}
+
+// 오류 응답 본문으로 게시글 접근 상태 판별
+// 삭제/권한 없음처럼 다시 요청해도 같은 게시글 상태만 true로 판별하고,
+// 요청 제한(429)이나 서버 오류(5xx) 등 일시적일 수 있는 응답은 false
+func classifyArticleError(statusCode int, body []byte) (ArticleStatus, string, bool) {
+	if statusCode == http.StatusTooManyRequests || statusCode >= 500 {
+		return "", "", false
+	}
+
+	var errResp articleErrorResponse
+	_ = json.Unmarshal(body, &errResp)
+
+	reason := errResp.Result.Reason
+	if reason == "" {
+		reason = errResp.Result.Message
+	}
+
+	switch {
+	case strings.Contains(reason, "블라인드"):
+		return ArticleBlinded, reason, true
+	case strings.Contains(reason, "삭제") || strings.Contains(reason, "존재하지 않"):
+		return ArticleDeleted, reason, true
+	case strings.Contains(reason, "등급"):
+		return ArticleLevelRequired, reason, true
+	case strings.Contains(reason, "권한") || strings.Contains(reason, "멤버") || strings.Contains(reason, "가입"):
+		return ArticleNoPermission, reason, true
+	}
+
+	// 메시지가 없으면 상태 코드로 추정
+	switch statusCode {
+	case http.StatusNotFound, http.StatusGone:
+		return ArticleDeleted, reason, true
+	case http.StatusForbidden:
+		return ArticleNoPermission, reason, true
+	}
+	return "", reason, false
+}
+
+// 크롤링 결과의 게시글 상태별 개수 요약 출력
+func logStatusSummary(posts []map[string]interface{}) {
+	counts := make(map[string]int)
+	for _, post := range posts {
+		status, _ := post["status"].(ArticleStatus)
+		if status == "" {
+			status = ArticleUnknownError
+		}
+		counts[string(status)]++
+	}
+
+	var keys []string
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s %d개", k, counts[k]))
+	}
+	log.Printf("📋 게시글 상태 요약: %s", strings.Join(parts, ", "))
+}
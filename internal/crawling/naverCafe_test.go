@@ -0,0 +1,524 @@
+package crawling
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"naverCrawler/internal/chunk"
+	"naverCrawler/internal/dedup"
+	"naverCrawler/internal/redact"
+	"naverCrawler/internal/textproc"
+)
+
+const (
+	testCafeID    = "12345"
+	testBoardID   = "7"
+	testBoardPath = "/cafe-web/cafe-boardlist-api/v1/cafes/12345/menus/7/articles"
+)
+
+func testArticlePath(id string) string {
+	return "/cafe-web/cafe-articleapi/v3/cafes/12345/articles/" + id
+}
+
+func testSession() *Session {
+	return NewSession("NID_AUT=aut; NID_SES=ses")
+}
+
+func TestGetPostList(t *testing.T) {
+	fake := newFakeNaver(t)
+	fake.route(testBoardPath, map[string]string{"page": "1", "pageSize": "15"}, http.StatusOK, "cafe_board_page1.json")
+
+	posts, info, err := getPostList(testCafeID, testBoardID, 1, 15, testSession())
+	if err != nil {
+		t.Fatalf("getPostList: %v", err)
+	}
+	if info.LastNavigationPageNumber != 1 || info.VisibleNextButton {
+		t.Errorf("page info = %+v, want last 1 without next button", info)
+	}
+
+	// 공지(NOTICE)는 제외
+	if len(posts) != 2 {
+		t.Fatalf("got %d posts, want 2", len(posts))
+	}
+	first := posts[0]
+	if first["id"] != 1001 || first["title"] != "신제품 사용 후기" || first["writer"] != "카페회원A" || first["member_key"] != "mKey-A" {
+		t.Errorf("unexpected first post: %v", first)
+	}
+	if first["read_count"] != 150 || first["comment_count"] != 2 || first["like_count"] != 7 {
+		t.Errorf("unexpected counts: %v", first)
+	}
+}
+
+func TestGetArticleDetail(t *testing.T) {
+	fake := newFakeNaver(t)
+	fake.route(testArticlePath("1001"), nil, http.StatusOK, "cafe_article_1001.json")
+	fake.route(testArticlePath("1002"), nil, http.StatusNotFound, "cafe_article_deleted.json")
+	fake.route(testArticlePath("1003"), nil, http.StatusOK, "cafe_article_blinded.json")
+	fake.route(testArticlePath("1004"), nil, http.StatusForbidden, "cafe_article_members_only.json")
+	fake.route(testArticlePath("1005"), nil, http.StatusForbidden, "cafe_article_level.json")
+	fake.route(testArticlePath("1006"), nil, http.StatusOK, "cafe_article_deleted.json")
+	fake.route(testArticlePath("1007"), nil, http.StatusTooManyRequests, "cafe_article_deleted.json")
+	fake.route(testArticlePath("1008"), nil, http.StatusInternalServerError, "login_required.json")
+
+	tests := []struct {
+		name       string
+		id         int
+		wantStatus ArticleStatus
+	}{
+		{"ok", 1001, ArticleOK},
+		{"deleted", 1002, ArticleDeleted},
+		{"blinded", 1003, ArticleBlinded},
+		{"members only", 1004, ArticleNoPermission},
+		{"level required", 1005, ArticleLevelRequired},
+		{"error body with 200", 1006, ArticleDeleted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detail, err := getArticleDetail(testCafeID, tt.id, testSession())
+			if err != nil {
+				t.Fatalf("getArticleDetail: %v", err)
+			}
+			if detail["status"] != tt.wantStatus {
+				t.Errorf("status = %v, want %v (reason: %v)", detail["status"], tt.wantStatus, detail["status_reason"])
+			}
+			if _, ok := detail["comments"].([]map[string]interface{}); !ok {
+				t.Errorf("comments has type %T", detail["comments"])
+			}
+		})
+	}
+
+	// 요청 제한이나 서버 오류는 게시글 상태가 아니라 오류
+	for _, id := range []int{1007, 1008} {
+		detail, err := getArticleDetail(testCafeID, id, testSession())
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || detail != nil {
+			t.Errorf("article %d: detail = %v, err = %v, want APIError", id, detail, err)
+		}
+	}
+
+	detail, _ := getArticleDetail(testCafeID, 1001, testSession())
+	comments := detail["comments"].([]map[string]interface{})
+	if len(comments) != 2 || comments[0]["content"] != "저도 샀어요" || comments[1]["member_key"] != "mKey-B" {
+		t.Errorf("unexpected comments: %v", comments)
+	}
+}
+
+func TestGetAPIResponseSessionExpired(t *testing.T) {
+	fake := newFakeNaver(t)
+	fake.route("/unauthorized", nil, http.StatusUnauthorized, "login_required.json")
+	fake.route("/login-required", nil, http.StatusForbidden, "login_required.json")
+	fake.route("/members-only", nil, http.StatusForbidden, "cafe_article_members_only.json")
+
+	for _, path := range []string{"/unauthorized", "/login-required"} {
+		_, err := getAPIResponse(fake.URL+path, testSession())
+		if !errors.Is(err, ErrSessionExpired) {
+			t.Errorf("%s: err = %v, want ErrSessionExpired", path, err)
+		}
+	}
+
+	_, err := getAPIResponse(fake.URL+"/members-only", testSession())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("members-only: err = %v, want APIError 403", err)
+	}
+	if errors.Is(err, ErrSessionExpired) {
+		t.Error("members-only article must not be reported as expired session")
+	}
+}
+
+// 임시 디렉토리에서 게시판 1페이지(게시글 1001, 삭제된 1002)를 돌려주는 가짜 서버 (article은 1001의 상세 응답 파일)
+func fakeBoardPage1(t *testing.T, article string) *fakeNaver {
+	t.Helper()
+	t.Chdir(t.TempDir())
+
+	fake := newFakeNaver(t)
+	fake.route(testBoardPath, map[string]string{"page": "1"}, http.StatusOK, "cafe_board_page1.json")
+	fake.route(testArticlePath("1001"), nil, http.StatusOK, article)
+	fake.route(testArticlePath("1002"), nil, http.StatusNotFound, "cafe_article_deleted.json")
+	return fake
+}
+
+func TestCrawlBoard(t *testing.T) {
+	fake := fakeBoardPage1(t, "cafe_article_1001.json")
+
+	fake.setLatency(20 * time.Millisecond)
+
+	posts, err := CrawlBoard(testCafeID, testBoardID, testSession(), CafeCrawlOptions{MaxPages: 10, PageSize: 15, DetailWorkers: 2})
+	if err != nil {
+		t.Fatalf("CrawlBoard: %v", err)
+	}
+	if len(posts) != 2 {
+		t.Fatalf("got %d posts, want 2", len(posts))
+	}
+
+	if posts[0]["status"] != ArticleOK || posts[0]["content"] == "" {
+		t.Errorf("first post: %v", posts[0])
+	}
+	if posts[1]["status"] != ArticleDeleted {
+		t.Errorf("second post status = %v, want deleted", posts[1]["status"])
+	}
+
+	if n := fake.peakInFlight(); n > 2 {
+		t.Errorf("max concurrent detail requests = %d, want <= 2", n)
+	}
+
+	files, _ := filepath.Glob(filepath.Join("output", "cafe_12345_board_7_*_full.json"))
+	if len(files) != 1 {
+		t.Errorf("full result files = %v", files)
+	}
+	pageFiles, _ := filepath.Glob(filepath.Join("output", "cafe_12345_board_7_*_page_1.json"))
+	if len(pageFiles) != 1 {
+		t.Errorf("page result files = %v", pageFiles)
+	}
+}
+
+func TestCrawlBoardSessionExpired(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(f *fakeNaver)
+	}{
+		{"on list page", func(f *fakeNaver) {
+			f.route(testBoardPath, nil, http.StatusUnauthorized, "login_required.json")
+		}},
+		{"while fetching details", func(f *fakeNaver) {
+			f.route(testBoardPath, nil, http.StatusOK, "cafe_board_page1.json")
+			f.route(testArticlePath("1001"), nil, http.StatusOK, "cafe_article_1001.json")
+			f.route(testArticlePath("1002"), nil, http.StatusUnauthorized, "login_required.json")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Chdir(t.TempDir())
+
+			fake := newFakeNaver(t)
+			tt.setup(fake)
+
+			_, err := CrawlBoard(testCafeID, testBoardID, testSession(), CafeCrawlOptions{MaxPages: 10, PageSize: 15})
+			if !errors.Is(err, ErrSessionExpired) {
+				t.Errorf("err = %v, want ErrSessionExpired", err)
+			}
+		})
+	}
+}
+
+func TestCrawlMember(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	fake := newFakeNaver(t)
+	fake.route("/cafe-web/cafe-mobile/CafeMemberNetworkArticleListV1", map[string]string{"search.memberKey": "mKey-A"}, http.StatusOK, "cafe_member_articles.json")
+	fake.route("/cafe-web/cafe-mobile/CafeMemberNetworkReplyListV1", nil, http.StatusOK, "cafe_member_comments_empty.json")
+	fake.route(testArticlePath("1001"), nil, http.StatusOK, "cafe_article_1001.json")
+
+	result, err := CrawlMember(testCafeID, CafeMember{MemberKey: "mKey-A"}, testSession(), CafeMemberOptions{MaxPages: 1})
+	if err != nil {
+		t.Fatalf("CrawlMember: %v", err)
+	}
+	// 작성글 목록 API에는 닉네임이 없어 상세 정보로 채워야 함
+	if result.NickName != "카페회원A" {
+		t.Errorf("NickName = %q, want 카페회원A", result.NickName)
+	}
+	if len(result.Articles) != 1 {
+		t.Fatalf("got %d articles, want 1", len(result.Articles))
+	}
+	article := result.Articles[0]
+	if article["writer"] != "카페회원A" || article["member_key"] != "mKey-A" || article["writer_level"] != "우수회원" {
+		t.Errorf("article writer info = %v", article)
+	}
+}
+
+func TestCrawlMemberStages(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	fake := newFakeNaver(t)
+	fake.route("/cafe-web/cafe-mobile/CafeMemberNetworkArticleListV1", map[string]string{"search.memberKey": "mKey-A"}, http.StatusOK, "cafe_member_articles.json")
+	fake.route("/cafe-web/cafe-mobile/CafeMemberNetworkReplyListV1", nil, http.StatusOK, "cafe_member_comments_empty.json")
+	fake.route(testArticlePath("1001"), nil, http.StatusOK, "cafe_article_pii.json")
+
+	r := redact.New("test-salt")
+	opts := CafeMemberOptions{
+		MaxPages: 1,
+		Redact:   r,
+		Text:     textproc.FeatureOptions(true, false),
+		Chunks:   &chunk.Options{MaxChars: 1000},
+	}
+	result, err := CrawlMember(testCafeID, CafeMember{MemberKey: "mKey-A"}, testSession(), opts)
+	if err != nil {
+		t.Fatalf("CrawlMember: %v", err)
+	}
+	if result.MemberKey != r.Writer("mKey-A") || result.NickName != r.Writer("카페회원A") {
+		t.Errorf("member = %q (%q)", result.NickName, result.MemberKey)
+	}
+
+	article := result.Articles[0]
+	if content, _ := article["content"].(string); !strings.Contains(content, "[전화번호] 또는 [이메일]") {
+		t.Errorf("content = %q", content)
+	}
+	if article["writer"] != r.Writer("카페회원A") || article["member_key"] != r.Writer("mKey-A") {
+		t.Errorf("writer = %q, member_key = %q", article["writer"], article["member_key"])
+	}
+	comment := article["comments"].([]map[string]interface{})[0]
+	if comment["content"] != "입금은 국민 [계좌번호] 로 할게요" {
+		t.Errorf("comment content = %q", comment["content"])
+	}
+	// 분석용 본문은 가린 본문으로 만듦
+	f, ok := article["text_features"].(textproc.Features)
+	if !ok || !strings.Contains(f.Text, "[전화번호] 또는 [이메일]") || len(f.Sentences) == 0 {
+		t.Errorf("text_features = %+v", article["text_features"])
+	}
+
+	// 결과 파일 이름에도 회원 키 대신 가명
+	prefix := filepath.Join("output", "cafe_12345_member_"+r.Writer("mKey-A")+"_")
+	if files, _ := filepath.Glob(prefix + "*[0-9].json"); len(files) != 1 {
+		t.Errorf("member result files = %v", files)
+	}
+	chunks := readChunks(t, prefix+"*_chunks.jsonl")
+	if len(chunks) != 1 || chunks[0].ID != "cafe:12345/1001#0" || strings.Contains(chunks[0].Text, "@") {
+		t.Fatalf("chunks = %+v", chunks)
+	}
+}
+
+func TestCrawlBoardDedup(t *testing.T) {
+	fake := fakeBoardPage1(t, "cafe_article_1001.json")
+
+	ix := dedup.New()
+	ix.MinRunes = 5
+	opts := CafeCrawlOptions{MaxPages: 1, PageSize: 15, Dedup: ix}
+
+	posts, err := CrawlBoard(testCafeID, testBoardID, testSession(), opts)
+	if err != nil {
+		t.Fatalf("CrawlBoard: %v", err)
+	}
+	if len(posts) != 2 || posts[0]["duplicate_cluster"] != "cafe:12345/1001" {
+		t.Fatalf("first run posts = %v", posts)
+	}
+	// 삭제된 게시글은 본문이 없어 클러스터 없음
+	if _, ok := posts[1]["duplicate_cluster"]; ok {
+		t.Errorf("deleted post has cluster %v", posts[1]["duplicate_cluster"])
+	}
+
+	// 이미 수집한 게시글과 삭제된 게시글(지문 없이 인덱스에 있음)은 상세 정보를 다시 요청하지 않고 제외
+	posts, err = CrawlBoard(testCafeID, testBoardID, testSession(), opts)
+	if err != nil {
+		t.Fatalf("second CrawlBoard: %v", err)
+	}
+	if len(posts) != 0 {
+		t.Errorf("second run posts = %v, want none", posts)
+	}
+	for _, id := range []string{"1001", "1002"} {
+		if n := fake.count(testArticlePath(id)); n != 1 {
+			t.Errorf("fetched article %s %d times, want 1", id, n)
+		}
+	}
+}
+
+func TestCrawlBoardDedupRetriesNoPermission(t *testing.T) {
+	fake := fakeBoardPage1(t, "cafe_article_members_only.json")
+
+	ix := dedup.New()
+	opts := CafeCrawlOptions{MaxPages: 1, PageSize: 15, Dedup: ix}
+	for run := 0; run < 2; run++ {
+		if _, err := CrawlBoard(testCafeID, testBoardID, testSession(), opts); err != nil {
+			t.Fatalf("CrawlBoard: %v", err)
+		}
+	}
+	// 권한 없는 게시글은 가입 후 볼 수 있으므로 인덱스에 넣지 않고 다시 확인
+	if n := fake.count(testArticlePath("1001")); n != 2 {
+		t.Errorf("fetched article 1001 %d times, want 2", n)
+	}
+	if ix.Has("cafe", "12345/1001") || !ix.Has("cafe", "12345/1002") {
+		t.Errorf("index has 1001 = %v, 1002 = %v; want only deleted 1002", ix.Has("cafe", "12345/1001"), ix.Has("cafe", "12345/1002"))
+	}
+}
+
+func TestCrawlBoardStopsAtCollectedPage(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	fake := newFakeNaver(t)
+	fake.route(testBoardPath, map[string]string{"page": "1"}, http.StatusOK, "cafe_board_page1_of2.json")
+	fake.route(testBoardPath, map[string]string{"page": "2"}, http.StatusOK, "cafe_board_page1_of2.json")
+
+	// 1페이지 게시글(공지 제외)을 모두 이전 실행에서 수집한 경우
+	ix := dedup.New()
+	ix.Add("cafe", "12345/1001", "1001")
+	ix.Add("cafe", "12345/1002", "1002")
+
+	posts, err := CrawlBoard(testCafeID, testBoardID, testSession(), CafeCrawlOptions{PageSize: 15, ListWorkers: 1, Dedup: ix})
+	if err != nil {
+		t.Fatalf("CrawlBoard: %v", err)
+	}
+	if len(posts) != 0 {
+		t.Errorf("got %d posts, want 0", len(posts))
+	}
+	if n := fake.count(testBoardPath); n != 1 {
+		t.Errorf("fetched %d list pages, want only page 1", n)
+	}
+}
+
+func TestCrawlBoardSessionExpiredWhileListing(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	fake := newFakeNaver(t)
+	expired := make(chan struct{})
+	fake.route(testBoardPath, map[string]string{"page": "1"}, http.StatusOK, "cafe_board_page1_of2.json")
+	// 2페이지(모두 수집한 게시글)는 1페이지 게시글의 세션 만료로 상세 작업자가 끝난 뒤에 도착
+	fake.handle(testBoardPath, func(w http.ResponseWriter, r *http.Request) {
+		<-expired
+		time.Sleep(50 * time.Millisecond)
+		data, _ := os.ReadFile(filepath.Join(testdataDir, "cafe_board_page2_of2.json"))
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.Write(data)
+	})
+	fake.handle(testArticlePath("1001"), func(w http.ResponseWriter, r *http.Request) {
+		defer close(expired)
+		data, _ := os.ReadFile(filepath.Join(testdataDir, "login_required.json"))
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write(data)
+	})
+
+	ix := dedup.New()
+	ix.Add("cafe", "12345/2001", "2001")
+
+	_, err := CrawlBoard(testCafeID, testBoardID, testSession(),
+		CafeCrawlOptions{PageSize: 15, ListWorkers: 2, DetailWorkers: 1, Dedup: ix})
+	if !errors.Is(err, ErrSessionExpired) {
+		t.Errorf("err = %v, want ErrSessionExpired", err)
+	}
+}
+
+func TestCrawlSearchDedup(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	fake := newFakeNaver(t)
+	fake.route("/cafe-web/cafe-mobile/CafeMobileWebArticleSearchListV4", map[string]string{"page": "1"}, http.StatusOK, "cafe_search_page1.json")
+	fake.route(testArticlePath("1001"), nil, http.StatusOK, "cafe_article_1001.json")
+	fake.route(testArticlePath("1002"), nil, http.StatusNotFound, "cafe_article_deleted.json")
+
+	ix := dedup.New()
+	ix.MinRunes = 5
+	opts := CafeSearchOptions{Query: "후기", MaxPages: 1, Dedup: ix}
+
+	posts, err := CrawlSearch(testCafeID, opts, testSession())
+	if err != nil {
+		t.Fatalf("CrawlSearch: %v", err)
+	}
+	if len(posts) != 2 || posts[0]["duplicate_cluster"] != "cafe:12345/1001" {
+		t.Fatalf("first run posts = %v", posts)
+	}
+
+	posts, err = CrawlSearch(testCafeID, opts, testSession())
+	if err != nil {
+		t.Fatalf("second CrawlSearch: %v", err)
+	}
+	if len(posts) != 0 {
+		t.Errorf("second run posts = %v, want none", posts)
+	}
+	if n := fake.count(testArticlePath("1001")); n != 1 {
+		t.Errorf("fetched article 1001 %d times, want 1", n)
+	}
+}
+
+func TestCrawlSearchStages(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	fake := newFakeNaver(t)
+	fake.route("/cafe-web/cafe-mobile/CafeMobileWebArticleSearchListV4", map[string]string{"page": "1"}, http.StatusOK, "cafe_search_page1.json")
+	fake.route(testArticlePath("1001"), nil, http.StatusOK, "cafe_article_pii.json")
+	fake.route(testArticlePath("1002"), nil, http.StatusNotFound, "cafe_article_deleted.json")
+
+	r := redact.New("test-salt")
+	opts := CafeSearchOptions{
+		Query:    "후기",
+		MaxPages: 1,
+		Redact:   r,
+		Text:     textproc.FeatureOptions(true, false),
+		Chunks:   &chunk.Options{MaxChars: 1000},
+	}
+	posts, err := CrawlSearch(testCafeID, opts, testSession())
+	if err != nil {
+		t.Fatalf("CrawlSearch: %v", err)
+	}
+	if len(posts) != 2 {
+		t.Fatalf("got %d posts, want 2", len(posts))
+	}
+
+	post := posts[0]
+	if post["writer"] != r.Writer("카페회원A") || post["member_key"] != r.Writer("mKey-A") {
+		t.Errorf("writer = %q, member_key = %q", post["writer"], post["member_key"])
+	}
+	// 분석용 본문은 가린 본문으로 만듦
+	f, ok := post["text_features"].(textproc.Features)
+	if !ok || !strings.Contains(f.Text, "[전화번호] 또는 [이메일]") || len(f.Sentences) == 0 || len(f.Tokens) == 0 {
+		t.Errorf("text_features = %+v", post["text_features"])
+	}
+
+	chunks := readChunks(t, filepath.Join("output", "cafe_12345_search_*_chunks.jsonl"))
+	if len(chunks) != 1 || chunks[0].ID != "cafe:12345/1001#0" {
+		t.Fatalf("chunks = %+v", chunks)
+	}
+}
+
+func TestCrawlBoardRedact(t *testing.T) {
+	fakeBoardPage1(t, "cafe_article_pii.json")
+
+	r := redact.New("test-salt")
+	posts, err := CrawlBoard(testCafeID, testBoardID, testSession(), CafeCrawlOptions{MaxPages: 1, PageSize: 15, Redact: r})
+	if err != nil {
+		t.Fatalf("CrawlBoard: %v", err)
+	}
+	if len(posts) != 2 {
+		t.Fatalf("got %d posts, want 2", len(posts))
+	}
+
+	post := posts[0]
+	wantContent := `<div class="se-main-container"><p>직거래 원합니다. [전화번호] 또는 [이메일] 으로 연락 주세요.</p><p>[주소] 앞에서 만나요.</p></div>`
+	if post["content"] != wantContent {
+		t.Errorf("content = %q", post["content"])
+	}
+	if post["writer"] != r.Writer("카페회원A") || post["member_key"] != r.Writer("mKey-A") {
+		t.Errorf("writer = %q, member_key = %q", post["writer"], post["member_key"])
+	}
+
+	comment := post["comments"].([]map[string]interface{})[0]
+	if comment["content"] != "입금은 국민 [계좌번호] 로 할게요" {
+		t.Errorf("comment content = %q", comment["content"])
+	}
+	if comment["writer"] != r.Writer("카페회원C") {
+		t.Errorf("comment writer = %q", comment["writer"])
+	}
+
+	// 같은 회원은 게시글이 달라도 같은 가명
+	if posts[1]["member_key"] != r.Writer("mKey-B") {
+		t.Errorf("deleted post member_key = %q", posts[1]["member_key"])
+	}
+}
+
+func TestCrawlBoardChunks(t *testing.T) {
+	fakeBoardPage1(t, "cafe_article_pii.json")
+
+	opts := CafeCrawlOptions{MaxPages: 1, PageSize: 15, Redact: redact.New(""), Chunks: &chunk.Options{MaxChars: 1000}}
+	if _, err := CrawlBoard(testCafeID, testBoardID, testSession(), opts); err != nil {
+		t.Fatalf("CrawlBoard: %v", err)
+	}
+
+	// 삭제된 게시글은 제외, 본문 HTML 문단은 빈 줄로 구분, 개인정보는 가린 본문으로
+	chunks := readChunks(t, filepath.Join("output", "cafe_12345_board_7_*_chunks.jsonl"))
+	if len(chunks) != 1 {
+		t.Fatalf("got %d chunks, want 1: %+v", len(chunks), chunks)
+	}
+	c := chunks[0]
+	want := "직거래 원합니다. [전화번호] 또는 [이메일] 으로 연락 주세요.\n\n[주소] 앞에서 만나요."
+	if c.Text != want {
+		t.Errorf("Text = %q, want %q", c.Text, want)
+	}
+	if c.ID != "cafe:12345/1001#0" || c.Writer != "카페회원A" || c.WriteDate == "" {
+		t.Errorf("metadata = %+v", c)
+	}
+}
@@ -0,0 +1,80 @@
+package crawling
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// 게시글 출처
+const (
+	SourceBlog = "blog"
+	SourceCafe = "cafe"
+)
+
+// PostRef 게시글 URL에서 찾은 게시글 위치
+type PostRef struct {
+	Source    string `json:"source"` // blog, cafe
+	BlogID    string `json:"blog_id,omitempty"`
+	LogNo     string `json:"log_no,omitempty"`
+	CafeID    string `json:"cafe_id,omitempty"`
+	ArticleID int    `json:"article_id,omitempty"`
+}
+
+var (
+	logNoPattern   = regexp.MustCompile(`^\d+$`)
+	cafeURLPattern = regexp.MustCompile(`^/ca-fe/(?:web/)?cafes/(\d+)/articles/(\d+)`)
+)
+
+// ParsePostURL 블로그/카페 게시글 URL 해석
+//
+//	https://blog.naver.com/{블로그ID}/{글번호}, https://m.blog.naver.com/{블로그ID}/{글번호}
+//	https://blog.naver.com/PostView.naver?blogId={블로그ID}&logNo={글번호}
+//	https://cafe.naver.com/ca-fe/cafes/{카페ID}/articles/{게시글ID}
+//	https://cafe.naver.com/ArticleRead.nhn?clubid={카페ID}&articleid={게시글ID}
+func ParsePostURL(raw string) (PostRef, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return PostRef{}, fmt.Errorf("URL 형식 오류: %v", err)
+	}
+	q := u.Query()
+
+	switch u.Host {
+	case "blog.naver.com", "m.blog.naver.com":
+		blogID, logNo := q.Get("blogId"), q.Get("logNo")
+		if blogID == "" || logNo == "" {
+			parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+			if len(parts) == 2 {
+				blogID, logNo = parts[0], parts[1]
+			}
+		}
+		if !blogIDPattern.MatchString(blogID) || !logNoPattern.MatchString(logNo) {
+			return PostRef{}, fmt.Errorf("블로그 게시글 URL이 아닙니다: %s", raw)
+		}
+		return PostRef{Source: SourceBlog, BlogID: blogID, LogNo: logNo}, nil
+
+	case "cafe.naver.com", "m.cafe.naver.com":
+		cafeID, articleID := q.Get("clubid"), q.Get("articleid")
+		if m := cafeURLPattern.FindStringSubmatch(u.Path); m != nil {
+			cafeID, articleID = m[1], m[2]
+		}
+		id, err := strconv.Atoi(articleID)
+		if err != nil || !logNoPattern.MatchString(cafeID) {
+			return PostRef{}, fmt.Errorf("카페 게시글 URL이 아닙니다 (카페 숫자 ID가 있는 URL만 지원): %s", raw)
+		}
+		return PostRef{Source: SourceCafe, CafeID: cafeID, ArticleID: id}, nil
+	}
+	return PostRef{}, fmt.Errorf("네이버 블로그/카페 URL이 아닙니다: %s", raw)
+}
+
+// 블로그 게시글 원문 주소 (백엔드와 상관없이 같은 형식으로 저장)
+func blogPostURL(blogID, logNo string) string {
+	return fmt.Sprintf("https://blog.naver.com/%s/%s", blogID, logNo)
+}
+
+// GetArticleDetail 카페 게시글 하나의 상세 정보 (삭제/권한 없음은 오류 대신 "status"로 표시)
+func GetArticleDetail(cafeId string, articleId int, session *Session) (map[string]interface{}, error) {
+	return getArticleDetail(cafeId, articleId, session)
+}
@@ -0,0 +1,30 @@
+package crawling
+
+import "testing"
+
+func TestParsePostURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		want    PostRef
+		wantErr bool
+	}{
+		{"https://blog.naver.com/allminwon/223428124420", PostRef{Source: SourceBlog, BlogID: "allminwon", LogNo: "223428124420"}, false},
+		{"https://m.blog.naver.com/allminwon/223428124420?referrerCode=0", PostRef{Source: SourceBlog, BlogID: "allminwon", LogNo: "223428124420"}, false},
+		{"https://blog.naver.com/PostView.naver?blogId=allminwon&logNo=223428124420", PostRef{Source: SourceBlog, BlogID: "allminwon", LogNo: "223428124420"}, false},
+		{"https://cafe.naver.com/ca-fe/cafes/12345/articles/1001?boardType=L", PostRef{Source: SourceCafe, CafeID: "12345", ArticleID: 1001}, false},
+		{"https://cafe.naver.com/ArticleRead.nhn?clubid=12345&articleid=1001", PostRef{Source: SourceCafe, CafeID: "12345", ArticleID: 1001}, false},
+		{"https://blog.naver.com/allminwon", PostRef{}, true},
+		{"https://cafe.naver.com/mycafe/1001", PostRef{}, true},
+		{"https://example.com/allminwon/1", PostRef{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParsePostURL(tt.url)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParsePostURL(%q) err = %v, wantErr %v", tt.url, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParsePostURL(%q) = %+v, want %+v", tt.url, got, tt.want)
+		}
+	}
+}
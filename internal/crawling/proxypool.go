@@ -0,0 +1,177 @@
+package crawling
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// 프록시 하나의 연결과 상태
+type proxyEntry struct {
+	url       *url.URL
+	transport *http.Transport
+	failures  int  // 연속 실패 횟수
+	healthy   bool // false면 상태 확인에 성공할 때까지 사용하지 않음
+}
+
+// 정상 프록시를 번갈아 사용하는 RoundTripper
+// 연속으로 실패한 프록시는 제외하고, 주기적인 상태 확인으로 다시 복구
+type proxyPool struct {
+	entries       []*proxyEntry
+	checkURL      string
+	checkInterval time.Duration
+	checkTimeout  time.Duration
+	maxFailures   int
+
+	mu   sync.Mutex
+	turn int
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func newProxyPool(cfg HTTPConfig) (*proxyPool, error) {
+	p := &proxyPool{
+		checkURL:      cfg.ProxyCheckURL,
+		checkInterval: cfg.ProxyCheckInterval,
+		checkTimeout:  cfg.Timeout,
+		maxFailures:   cfg.ProxyMaxFailures,
+		stop:          make(chan struct{}),
+	}
+	for _, raw := range cfg.Proxies {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("프록시 주소 형식 오류 (%s): %v", raw, err)
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5", "socks5h":
+		default:
+			return nil, fmt.Errorf("지원하지 않는 프록시 형식입니다: %s", u.Redacted())
+		}
+		p.entries = append(p.entries, &proxyEntry{
+			url:       u,
+			transport: newTransport(cfg, u),
+			healthy:   true,
+		})
+	}
+	return p, nil
+}
+
+// 다음에 사용할 프록시 (정상 프록시가 없으면 전체 중에서 선택)
+func (p *proxyPool) pick() *proxyEntry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for range p.entries {
+		e := p.entries[p.turn%len(p.entries)]
+		p.turn++
+		if e.healthy {
+			return e
+		}
+	}
+
+	// 모두 제외된 상태라도 크롤링을 멈추지 않도록 순서대로 시도
+	e := p.entries[p.turn%len(p.entries)]
+	p.turn++
+	return e
+}
+
+func (p *proxyPool) RoundTrip(req *http.Request) (*http.Response, error) {
+	e := p.pick()
+	resp, err := e.transport.RoundTrip(req)
+	if err != nil || resp.StatusCode == http.StatusProxyAuthRequired {
+		p.report(e, false)
+	} else {
+		p.report(e, true)
+	}
+	return resp, err
+}
+
+// 요청 결과 반영
+func (p *proxyPool) report(e *proxyEntry, ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if ok {
+		e.failures = 0
+		if !e.healthy {
+			log.Printf("✅ 프록시 복구: %s", e.url.Redacted())
+		}
+		e.healthy = true
+		return
+	}
+
+	e.failures++
+	if e.healthy && e.failures >= p.maxFailures {
+		e.healthy = false
+		log.Printf("🚫 프록시 %d회 연속 실패로 제외: %s", e.failures, e.url.Redacted())
+	}
+}
+
+// 모든 프록시 상태 확인
+func (p *proxyPool) checkAll() {
+	var wg sync.WaitGroup
+	for _, e := range p.entries {
+		wg.Add(1)
+		go func(e *proxyEntry) {
+			defer wg.Done()
+			p.report(e, p.check(e) == nil)
+		}(e)
+	}
+	wg.Wait()
+}
+
+func (p *proxyPool) check(e *proxyEntry) error {
+	c := &http.Client{Transport: e.transport, Timeout: p.checkTimeout}
+	resp, err := c.Get(p.checkURL)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// 주기적인 상태 확인 시작 (확인 주소가 없으면 요청 실패로만 제외)
+func (p *proxyPool) Start() {
+	if p.checkURL == "" {
+		return
+	}
+	p.checkAll()
+	log.Printf("🌐 프록시 %d개 중 %d개 사용 가능", len(p.entries), p.healthyCount())
+
+	go func() {
+		ticker := time.NewTicker(p.checkInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				p.checkAll()
+			case <-p.stop:
+				return
+			}
+		}
+	}()
+}
+
+// 상태 확인 중지
+func (p *proxyPool) Stop() {
+	p.stopOnce.Do(func() { close(p.stop) })
+}
+
+func (p *proxyPool) healthyCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	n := 0
+	for _, e := range p.entries {
+		if e.healthy {
+			n++
+		}
+	}
+	return n
+}
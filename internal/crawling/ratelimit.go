@@ -0,0 +1,46 @@
+package crawling
+
+import (
+	"sync"
+	"time"
+)
+
+// 여러 작업자가 공유하는 요청 간격 제한 (동시 작업자 수와 관계없이 전체 요청 속도를 유지)
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// 블로그 요청 기본 간격
+const defaultBlogRequestInterval = 300 * time.Millisecond
+
+var blogLimiter = &rateLimiter{interval: defaultBlogRequestInterval}
+
+// 다음 요청 차례까지 대기
+func (l *rateLimiter) Wait() {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+}
+
+func (l *rateLimiter) SetInterval(interval time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.interval = interval
+	l.next = time.Time{}
+}
+
+// SetBlogRateLimit 블로그 요청 사이 최소 간격 설정 (0이면 제한 없음)
+func SetBlogRateLimit(interval time.Duration) {
+	blogLimiter.SetInterval(interval)
+}
@@ -0,0 +1,84 @@
+package crawling
+
+import "naverCrawler/internal/redact"
+
+// 블로그 게시글의 제목/본문/댓글에서 개인정보를 가리고 작성자를 가명으로 바꿈
+func redactBlogPosts(r *redact.Redactor, posts []BlogPost) []BlogPost {
+	if r == nil {
+		return posts
+	}
+
+	for i := range posts {
+		post := &posts[i]
+		post.Title = r.Text(post.Title)
+		post.Content = r.Text(post.Content)
+		for j := range post.Paragraphs {
+			post.Paragraphs[j] = r.Text(post.Paragraphs[j])
+		}
+		post.Writer = r.Writer(post.Writer)
+
+		for j := range post.Comments {
+			comment := &post.Comments[j]
+			comment.Content = r.Text(comment.Content)
+			comment.Writer = r.Writer(comment.Writer)
+			comment.WriterBlogID = r.Writer(comment.WriterBlogID)
+		}
+	}
+	return posts
+}
+
+// 카페 게시글의 제목/본문/댓글에서 개인정보를 가리고 작성자 닉네임/회원 키를 가명으로 바꿈
+func redactCafeJobs(r *redact.Redactor, jobs []articleJob) []articleJob {
+	if r == nil {
+		return jobs
+	}
+
+	for _, job := range jobs {
+		redactCafePost(r, job.post)
+	}
+	return jobs
+}
+
+// 회원 크롤링 결과의 작성글/작성댓글에서 개인정보를 가리고 회원 닉네임/키를 가명으로 바꿈
+func redactMemberResult(r *redact.Redactor, result *CafeMemberResult) {
+	if r == nil {
+		return
+	}
+
+	result.MemberKey = r.Writer(result.MemberKey)
+	result.NickName = r.Writer(result.NickName)
+	for _, article := range result.Articles {
+		redactCafePost(r, article)
+	}
+	for _, comment := range result.Comments {
+		redactCafeFields(r, comment)
+		if s, ok := comment["article_title"].(string); ok {
+			comment["article_title"] = r.Text(s)
+		}
+		if s, ok := comment["article_writer"].(string); ok {
+			comment["article_writer"] = r.Writer(s)
+		}
+	}
+}
+
+func redactCafePost(r *redact.Redactor, post map[string]interface{}) {
+	redactCafeFields(r, post)
+	if comments, ok := post["comments"].([]map[string]interface{}); ok {
+		for _, comment := range comments {
+			redactCafeFields(r, comment)
+		}
+	}
+}
+
+func redactCafeFields(r *redact.Redactor, m map[string]interface{}) {
+	for _, key := range []string{"title", "content"} {
+		if s, ok := m[key].(string); ok {
+			m[key] = r.Text(s)
+		}
+	}
+	for _, key := range []string{"writer", "member_key"} {
+		if s, ok := m[key].(string); ok {
+			m[key] = r.Writer(s)
+		}
+	}
+}
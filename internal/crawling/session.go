@@ -0,0 +1,379 @@
+package crawling
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrSessionExpired 로그인 세션이 없거나 만료되었을 때 반환되는 에러
+var ErrSessionExpired = errors.New("네이버 로그인 세션이 만료되었거나 유효하지 않습니다")
+
+// ErrNoSessionEnv 로그인 세션 환경 변수가 하나도 없을 때 SessionFromEnv가 반환하는 에러
+var ErrNoSessionEnv = errors.New("NAVER_COOKIE 또는 NAVER_COOKIE_FILE 환경 변수가 설정되지 않았습니다")
+
+// 로그인 상태를 판단하는 네이버 인증 쿠키
+var requiredCookies = []string{"NID_AUT", "NID_SES"}
+
+// Session 네이버 로그인 쿠키와 갱신 상태를 관리
+type Session struct {
+	mu         sync.Mutex
+	cookies    []*http.Cookie
+	path       string    // 쿠키 파일 경로 (문자열로 만든 세션은 비어있음)
+	modTime    time.Time // 마지막으로 읽은 쿠키 파일 수정 시각
+	generation int       // 쿠키가 다시 로드될 때마다 증가
+	waiting    bool      // 쿠키 파일 갱신을 기다리는 중
+
+	// 0보다 크면 세션 만료 시 쿠키 파일이 갱신될 때까지 최대 이 시간만큼 기다린 후 재시도
+	WaitForRefresh time.Duration
+}
+
+// NewSession "name=value; name2=value2" 형식의 쿠키 문자열로 세션 생성
+func NewSession(cookie string) *Session {
+	return &Session{cookies: parseCookieHeader(cookie)}
+}
+
+// LoadSession Netscape cookies.txt 또는 JSON 쿠키 내보내기 파일로 세션 생성
+func LoadSession(path string) (*Session, error) {
+	s := &Session{path: path}
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// SessionFromEnv 환경 변수에서 로그인 세션 읽기 (둘 다 없으면 ErrNoSessionEnv)
+// NAVER_COOKIE_FILE (cookies.txt 또는 JSON 내보내기)이 NAVER_COOKIE 문자열보다 우선하고,
+// NAVER_COOKIE_WAIT (예: 10m)는 세션 만료 시 쿠키 파일 갱신을 기다릴 시간
+func SessionFromEnv() (*Session, error) {
+	if path := os.Getenv("NAVER_COOKIE_FILE"); path != "" {
+		s, err := LoadSession(path)
+		if err != nil {
+			return nil, err
+		}
+		if v := os.Getenv("NAVER_COOKIE_WAIT"); v != "" {
+			wait, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, fmt.Errorf("NAVER_COOKIE_WAIT 형식 오류: %v", err)
+			}
+			s.WaitForRefresh = wait
+		}
+		return s, nil
+	}
+	if cookie := os.Getenv("NAVER_COOKIE"); cookie != "" {
+		return NewSession(cookie), nil
+	}
+	return nil, ErrNoSessionEnv
+}
+
+// CookieHeader 요청에 사용할 Cookie 헤더 값
+func (s *Session) CookieHeader() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var parts []string
+	for _, c := range s.cookies {
+		parts = append(parts, c.Name+"="+c.Value)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Validate 크롤링 시작 전 인증 쿠키가 있고 만료되지 않았는지 확인 (요청 없이 쿠키만 확인, 서버 확인은 Verify)
+func (s *Session) Validate() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for _, name := range requiredCookies {
+		var found *http.Cookie
+		for _, c := range s.cookies {
+			if c.Name == name {
+				found = c
+				break
+			}
+		}
+		if found == nil {
+			return fmt.Errorf("%w: %s 쿠키가 없습니다", ErrSessionExpired, name)
+		}
+		if !found.Expires.IsZero() && found.Expires.Before(now) {
+			return fmt.Errorf("%w: %s 쿠키가 %s에 만료되었습니다", ErrSessionExpired, name, found.Expires.Format("2006-01-02 15:04:05"))
+		}
+	}
+	return nil
+}
+
+// 로그인해야 볼 수 있는 가입 카페 목록 (세션 확인용, 한 건만 요청)
+const sessionProbePath = "/cafe-home-web/cafe-home/v1/cafes/join?page=1&perPage=1"
+
+// Verify 인증 쿠키를 확인한 뒤(Validate) 네이버에 요청을 하나 보내 서버에서도 로그인 상태인지 확인
+// 서버에서 만료된 세션은 ErrSessionExpired, 네트워크 오류 등으로 확인하지 못하면 경고만 남기고 통과
+func (s *Session) Verify() error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("GET", endpoints.CafeAPI+sessionProbePath, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Cookie", s.CookieHeader())
+	req.Header.Set("Referer", "https://section.cafe.naver.com")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Printf("⚠️ 세션 확인 요청 실패, 크롤링 중에 다시 확인합니다: %v", err)
+		return nil
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if isLoginWall(resp, body) {
+		return fmt.Errorf("%w: 서버에서 로그인 상태가 아닙니다 (HTTP %d)", ErrSessionExpired, resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.Printf("⚠️ 세션 확인 응답 HTTP %d, 크롤링 중에 다시 확인합니다", resp.StatusCode)
+	}
+	return nil
+}
+
+// 쿠키 파일을 다시 읽어 세션 갱신
+func (s *Session) reload() error {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return fmt.Errorf("쿠키 파일 확인 실패: %v", err)
+	}
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("쿠키 파일 읽기 실패: %v", err)
+	}
+
+	cookies, err := parseCookieFile(data)
+	if err != nil {
+		return fmt.Errorf("쿠키 파일 파싱 실패 (%s): %v", s.path, err)
+	}
+	if len(cookies) == 0 {
+		return fmt.Errorf("쿠키 파일에 네이버 쿠키가 없습니다: %s", s.path)
+	}
+
+	s.cookies = cookies
+	s.modTime = info.ModTime()
+	s.generation++
+	return nil
+}
+
+// 쿠키 파일 갱신 확인 간격 (테스트에서는 짧게 교체)
+var refreshPollInterval = 5 * time.Second
+
+// 세션 만료 시 쿠키 파일이 갱신될 때까지 대기
+// seen은 요청을 보낼 당시의 세대 번호로, 그 사이 다른 요청이 이미 갱신했다면 바로 반환
+// 기다리는 동안에는 잠금을 풀어 다른 요청의 CookieHeader가 막히지 않게 함
+func (s *Session) waitForRefresh(seen int) error {
+	s.mu.Lock()
+	if s.generation != seen {
+		s.mu.Unlock()
+		return nil
+	}
+	if s.path == "" || s.WaitForRefresh <= 0 {
+		s.mu.Unlock()
+		return ErrSessionExpired
+	}
+	wait := s.WaitForRefresh
+	if !s.waiting {
+		s.waiting = true
+		log.Printf("⏸️ 세션이 만료되었습니다. %s 쿠키 파일을 갱신해주세요 (최대 %s 대기)", s.path, wait)
+	}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.waiting = false
+		s.mu.Unlock()
+	}()
+
+	deadline := time.Now().Add(wait)
+	for time.Now().Before(deadline) {
+		time.Sleep(refreshPollInterval)
+		if s.refreshed(seen) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s 동안 쿠키 파일이 갱신되지 않았습니다", ErrSessionExpired, wait)
+}
+
+// 다른 요청이 이미 갱신했거나 쿠키 파일이 바뀌어 다시 읽었으면 true
+func (s *Session) refreshed(seen int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.generation != seen {
+		return true
+	}
+	info, err := os.Stat(s.path)
+	if err != nil || !info.ModTime().After(s.modTime) {
+		return false
+	}
+	if err := s.reload(); err != nil {
+		log.Printf("⚠️ 쿠키 파일 다시 읽기 실패: %v", err)
+		return false
+	}
+	log.Printf("▶️ 쿠키 파일이 갱신되어 크롤링을 재개합니다")
+	return true
+}
+
+// 현재 쿠키 세대 번호
+func (s *Session) currentGeneration() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.generation
+}
+
+// 로그인 페이지로 리다이렉트되었거나 인증 오류인 응답인지 확인
+func isLoginWall(resp *http.Response, body []byte) bool {
+	if resp.StatusCode == http.StatusUnauthorized {
+		return true
+	}
+	if resp.Request != nil && resp.Request.URL.Host == "nid.naver.com" {
+		return true
+	}
+	if resp.StatusCode == http.StatusForbidden {
+		// 권한 없는 게시글도 403을 반환하므로 로그인 요구 응답만 구분
+		return bytes.Contains(body, []byte("nidlogin")) ||
+			bytes.Contains(body, []byte("로그인이 필요")) ||
+			bytes.Contains(body, []byte("\"NOT_LOGIN\""))
+	}
+	return false
+}
+
+// "a=b; c=d" 형식의 쿠키 문자열 파싱
+func parseCookieHeader(cookie string) []*http.Cookie {
+	var cookies []*http.Cookie
+	for _, part := range strings.Split(cookie, ";") {
+		name, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok || name == "" {
+			continue
+		}
+		cookies = append(cookies, &http.Cookie{Name: name, Value: value})
+	}
+	return cookies
+}
+
+// 쿠키 파일 형식(JSON 또는 Netscape)을 판별해 파싱
+func parseCookieFile(data []byte) ([]*http.Cookie, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && (trimmed[0] == '[' || trimmed[0] == '{') {
+		return parseJSONCookies(trimmed)
+	}
+	return parseNetscapeCookies(bytes.NewReader(data))
+}
+
+// 브라우저 확장(EditThisCookie, Cookie-Editor 등)의 JSON 내보내기 형식
+func parseJSONCookies(data []byte) ([]*http.Cookie, error) {
+	type jsonCookie struct {
+		Domain         string  `json:"domain"`
+		Name           string  `json:"name"`
+		Value          string  `json:"value"`
+		Path           string  `json:"path"`
+		ExpirationDate float64 `json:"expirationDate"`
+		Expires        float64 `json:"expires"`
+		Secure         bool    `json:"secure"`
+		HttpOnly       bool    `json:"httpOnly"`
+	}
+
+	var list []jsonCookie
+	if data[0] == '{' {
+		var wrapped struct {
+			Cookies []jsonCookie `json:"cookies"`
+		}
+		if err := json.Unmarshal(data, &wrapped); err != nil {
+			return nil, err
+		}
+		list = wrapped.Cookies
+	} else if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+
+	var cookies []*http.Cookie
+	for _, c := range list {
+		if !isNaverDomain(c.Domain) {
+			continue
+		}
+		cookie := &http.Cookie{
+			Name:     c.Name,
+			Value:    c.Value,
+			Domain:   c.Domain,
+			Path:     c.Path,
+			Secure:   c.Secure,
+			HttpOnly: c.HttpOnly,
+		}
+		expires := c.ExpirationDate
+		if expires == 0 {
+			expires = c.Expires
+		}
+		if expires > 0 {
+			cookie.Expires = time.Unix(int64(expires), 0)
+		}
+		cookies = append(cookies, cookie)
+	}
+	return cookies, nil
+}
+
+// Netscape cookies.txt 형식 (curl, yt-dlp, cookies.txt 확장 등)
+func parseNetscapeCookies(r io.Reader) ([]*http.Cookie, error) {
+	var cookies []*http.Cookie
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		// 값이 빈 쿠키는 마지막 탭 뒤가 비어있으므로 줄 끝 공백은 지우지 않음
+		line := strings.TrimRight(scanner.Text(), "\r\n")
+		httpOnly := false
+		if strings.HasPrefix(line, "#HttpOnly_") {
+			line = strings.TrimPrefix(line, "#HttpOnly_")
+			httpOnly = true
+		}
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) == 6 {
+			// 값이 빈 쿠키를 끝 탭 없이 내보내는 도구도 있음
+			fields = append(fields, "")
+		}
+		if len(fields) != 7 {
+			return nil, fmt.Errorf("%d번째 줄: 필드 수가 7개가 아닙니다", lineNo)
+		}
+		if !isNaverDomain(fields[0]) {
+			continue
+		}
+
+		cookie := &http.Cookie{
+			Domain:   fields[0],
+			Path:     fields[2],
+			Secure:   strings.EqualFold(fields[3], "TRUE"),
+			Name:     fields[5],
+			Value:    fields[6],
+			HttpOnly: httpOnly,
+		}
+		if expires, err := strconv.ParseInt(fields[4], 10, 64); err == nil && expires > 0 {
+			cookie.Expires = time.Unix(expires, 0)
+		}
+		cookies = append(cookies, cookie)
+	}
+	return cookies, scanner.Err()
+}
+
+func isNaverDomain(domain string) bool {
+	domain = strings.TrimPrefix(domain, ".")
+	return domain == "naver.com" || strings.HasSuffix(domain, ".naver.com")
+}
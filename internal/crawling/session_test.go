@@ -0,0 +1,173 @@
+package crawling
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadSession(t *testing.T) {
+	tests := []struct {
+		name      string
+		file      string
+		wantValid bool
+	}{
+		{"netscape cookies.txt", "cookies.txt", true},
+		{"json export with expired NID_SES", "cookies.json", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session, err := LoadSession(filepath.Join("testdata", tt.file))
+			if err != nil {
+				t.Fatalf("LoadSession: %v", err)
+			}
+
+			header := session.CookieHeader()
+			if !strings.Contains(header, "NID_AUT=aut-value") || !strings.Contains(header, "NID_SES=ses-value") {
+				t.Errorf("CookieHeader = %q", header)
+			}
+			if strings.Contains(header, "ignored") {
+				t.Errorf("non-naver cookie leaked into header: %q", header)
+			}
+
+			err = session.Validate()
+			if tt.wantValid && err != nil {
+				t.Errorf("Validate: %v", err)
+			}
+			if !tt.wantValid && !errors.Is(err, ErrSessionExpired) {
+				t.Errorf("Validate = %v, want ErrSessionExpired", err)
+			}
+		})
+	}
+}
+
+func TestNewSessionValidate(t *testing.T) {
+	if err := NewSession("NID_AUT=a; NID_SES=b; NNB=c").Validate(); err != nil {
+		t.Errorf("Validate: %v", err)
+	}
+	if err := NewSession("NNB=c").Validate(); !errors.Is(err, ErrSessionExpired) {
+		t.Errorf("Validate without NID cookies = %v, want ErrSessionExpired", err)
+	}
+}
+
+func TestParseNetscapeCookiesEmptyValue(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+	}{
+		{"trailing tab", ".naver.com\tTRUE\t/\tFALSE\t0\tNNB\t\r\n"},
+		{"six fields", ".naver.com\tTRUE\t/\tFALSE\t0\tNNB\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := ".naver.com\tTRUE\t/\tTRUE\t0\tNID_AUT\taut\n" + tt.line
+			cookies, err := parseNetscapeCookies(strings.NewReader(data))
+			if err != nil {
+				t.Fatalf("parseNetscapeCookies: %v", err)
+			}
+			if len(cookies) != 2 || cookies[1].Name != "NNB" || cookies[1].Value != "" {
+				t.Errorf("cookies = %v", cookies)
+			}
+		})
+	}
+}
+
+func TestSessionVerify(t *testing.T) {
+	fake := newFakeNaver(t)
+	fake.route("/cafe-home-web/cafe-home/v1/cafes/join", map[string]string{"perPage": "1"}, http.StatusOK, "cafe_join_list.json")
+
+	if err := testSession().Verify(); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+	if err := NewSession("NNB=c").Verify(); !errors.Is(err, ErrSessionExpired) {
+		t.Errorf("Verify without NID cookies = %v, want ErrSessionExpired", err)
+	}
+
+	// 쿠키는 있지만 서버에서 만료된 세션
+	expired := newFakeNaver(t)
+	expired.route("/cafe-home-web/cafe-home/v1/cafes/join", nil, http.StatusUnauthorized, "login_required.json")
+	if err := testSession().Verify(); !errors.Is(err, ErrSessionExpired) {
+		t.Errorf("Verify with expired server session = %v, want ErrSessionExpired", err)
+	}
+}
+
+func TestWaitForRefreshReleasesLock(t *testing.T) {
+	prev := refreshPollInterval
+	refreshPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { refreshPollInterval = prev })
+
+	path := filepath.Join(t.TempDir(), "cookies.txt")
+	data, err := os.ReadFile(filepath.Join("testdata", "cookies.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	session, err := LoadSession(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session.WaitForRefresh = 5 * time.Second
+
+	done := make(chan error, 1)
+	go func() { done <- session.waitForRefresh(session.currentGeneration()) }()
+
+	// 기다리는 동안에도 다른 요청은 쿠키를 읽을 수 있어야 함
+	header := make(chan string, 1)
+	go func() { header <- session.CookieHeader() }()
+	select {
+	case <-header:
+	case <-time.After(time.Second):
+		t.Fatal("CookieHeader blocked while waiting for refresh")
+	}
+
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("waitForRefresh: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("waitForRefresh did not notice refreshed cookie file")
+	}
+}
+
+func TestSessionFromEnv(t *testing.T) {
+	t.Setenv("NAVER_COOKIE_FILE", "")
+	t.Setenv("NAVER_COOKIE_WAIT", "")
+	t.Setenv("NAVER_COOKIE", "")
+	if _, err := SessionFromEnv(); !errors.Is(err, ErrNoSessionEnv) {
+		t.Errorf("no env: err = %v, want ErrNoSessionEnv", err)
+	}
+
+	t.Setenv("NAVER_COOKIE", "NID_AUT=a; NID_SES=b")
+	session, err := SessionFromEnv()
+	if err != nil || session.CookieHeader() != "NID_AUT=a; NID_SES=b" {
+		t.Errorf("NAVER_COOKIE: session = %v, err = %v", session, err)
+	}
+
+	// 쿠키 파일이 문자열보다 우선
+	t.Setenv("NAVER_COOKIE_FILE", filepath.Join("testdata", "cookies.txt"))
+	t.Setenv("NAVER_COOKIE_WAIT", "10m")
+	session, err = SessionFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(session.CookieHeader(), "NID_AUT=aut-value") || session.WaitForRefresh != 10*time.Minute {
+		t.Errorf("NAVER_COOKIE_FILE: header = %q, wait = %v", session.CookieHeader(), session.WaitForRefresh)
+	}
+
+	t.Setenv("NAVER_COOKIE_WAIT", "10분")
+	if _, err := SessionFromEnv(); err == nil || errors.Is(err, ErrNoSessionEnv) {
+		t.Errorf("bad NAVER_COOKIE_WAIT: err = %v", err)
+	}
+}
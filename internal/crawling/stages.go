@@ -0,0 +1,32 @@
+package crawling
+
+import (
+	"naverCrawler/internal/dedup"
+	"naverCrawler/internal/redact"
+	"naverCrawler/internal/textproc"
+)
+
+// 목록 한 페이지의 게시글에 저장 전에 차례로 적용하는 후처리 단계 (CrawlBlog, CrawlBoard, CrawlSearch 공통)
+//
+//  1. 중복 확인: 가리기 전 원문으로 비교해야 이전 실행의 지문과 맞음
+//  2. 개인정보 가리기
+//  3. 분석용 본문 처리: 가린 본문으로 만들어 text_features에 개인정보가 남지 않음
+//
+// 청크는 크롤링이 끝난 뒤 이 단계를 거친 게시글로 만듦
+type postStages struct {
+	index    *dedup.Index
+	redactor *redact.Redactor
+	text     *textproc.Options
+}
+
+func (s postStages) blog(blogID string, posts []BlogPost) []BlogPost {
+	posts = dedupBlogPosts(s.index, blogID, posts)
+	posts = redactBlogPosts(s.redactor, posts)
+	return processBlogText(s.text, posts)
+}
+
+func (s postStages) cafe(cafeId string, jobs []articleJob) []articleJob {
+	jobs = dedupCafeJobs(s.index, cafeId, jobs)
+	jobs = redactCafeJobs(s.redactor, jobs)
+	return processCafeText(s.text, jobs)
+}
@@ -0,0 +1,39 @@
+package crawling
+
+import "naverCrawler/internal/textproc"
+
+// 블로그 게시글 본문을 분석용으로 처리해 TextFeatures에 저장
+func processBlogText(opts *textproc.Options, posts []BlogPost) []BlogPost {
+	if opts == nil {
+		return posts
+	}
+
+	for i := range posts {
+		f := textproc.Process(posts[i].Content, *opts)
+		posts[i].Content = f.Text
+		posts[i].TextFeatures = &f
+		if opts.StripEmoji {
+			for j, p := range posts[i].Paragraphs {
+				posts[i].Paragraphs[j] = textproc.StripEmoji(p)
+			}
+		}
+	}
+	return posts
+}
+
+// 카페 게시글 본문 HTML에서 텍스트를 뽑아 분석용으로 처리해 "text_features"에 저장
+func processCafeText(opts *textproc.Options, jobs []articleJob) []articleJob {
+	if opts == nil {
+		return jobs
+	}
+
+	for _, job := range jobs {
+		contentHTML, _ := job.post["content"].(string)
+		if contentHTML == "" {
+			continue
+		}
+		f := textproc.Process(htmlText(contentHTML), *opts)
+		job.post["text_features"] = f
+	}
+	return jobs
+}
@@ -0,0 +1,320 @@
+package crawling
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// UserAgentProfile 요청에 함께 보내는 브라우저 식별 헤더 묶음
+type UserAgentProfile struct {
+	UserAgent      string
+	AcceptLanguage string
+	SecCHUA        string // Chromium 계열만 보냄 (비어 있으면 생략)
+	Platform       string // Sec-CH-UA-Platform
+}
+
+// DefaultUserAgentProfiles 기본으로 번갈아 사용하는 데스크톱 브라우저 프로필
+var DefaultUserAgentProfiles = []UserAgentProfile{
+	{
+		UserAgent:      "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/136.0.0.0 Safari/537.36",
+		AcceptLanguage: "ko-KR,ko;q=0.9,en-US;q=0.8,en;q=0.7",
+		SecCHUA:        `"Chromium";v="136", "Google Chrome";v="136", "Not.A/Brand";v="99"`,
+		Platform:       `"macOS"`,
+	},
+	{
+		UserAgent:      "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/136.0.0.0 Safari/537.36",
+		AcceptLanguage: "ko-KR,ko;q=0.9,en-US;q=0.8,en;q=0.7",
+		SecCHUA:        `"Chromium";v="136", "Google Chrome";v="136", "Not.A/Brand";v="99"`,
+		Platform:       `"Windows"`,
+	},
+	{
+		UserAgent:      "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/136.0.0.0 Safari/537.36 Edg/136.0.0.0",
+		AcceptLanguage: "ko,en;q=0.9,en-US;q=0.8",
+		SecCHUA:        `"Chromium";v="136", "Microsoft Edge";v="136", "Not.A/Brand";v="99"`,
+		Platform:       `"Windows"`,
+	},
+	{
+		UserAgent:      "Mozilla/5.0 (Macintosh; Intel Mac OS X 10.15; rv:138.0) Gecko/20100101 Firefox/138.0",
+		AcceptLanguage: "ko-KR,ko;q=0.8,en-US;q=0.5,en;q=0.3",
+	},
+}
+
+// HTTPConfig 블로그/카페 요청에 공통으로 쓰는 HTTP 클라이언트 설정 (0 값은 기본값 사용)
+type HTTPConfig struct {
+	Timeout               time.Duration // 요청 하나의 전체 제한 시간 (기본 10초)
+	DialTimeout           time.Duration // 연결 제한 시간 (기본 5초)
+	TLSHandshakeTimeout   time.Duration // TLS 핸드셰이크 제한 시간 (기본 5초)
+	ResponseHeaderTimeout time.Duration // 응답 헤더 대기 제한 시간 (기본 0, 전체 제한 시간만 적용)
+
+	MaxIdleConns        int           // 전체 유휴 연결 수 (기본 100)
+	MaxIdleConnsPerHost int           // 호스트당 유휴 연결 수 (기본 10)
+	MaxConnsPerHost     int           // 호스트당 최대 연결 수 (0은 무제한)
+	IdleConnTimeout     time.Duration // 유휴 연결 유지 시간 (기본 90초)
+
+	DisableHTTP2 bool // HTTP/1.1만 사용
+
+	// 프록시 주소 (http://, https://, socks5://), 여러 개면 정상 프록시를 번갈아 사용
+	Proxies            []string
+	ProxyCheckURL      string        // 프록시 상태 확인 주소 (비어 있으면 상태 확인 안 함)
+	ProxyCheckInterval time.Duration // 상태 확인 주기 (기본 1분)
+	ProxyMaxFailures   int           // 연속 실패 시 제외할 횟수 (기본 3)
+
+	UserAgents []UserAgentProfile // 번갈아 사용할 프로필 (비어 있으면 DefaultUserAgentProfiles)
+}
+
+// DefaultHTTPConfig 기본 설정
+var DefaultHTTPConfig = HTTPConfig{}
+
+// 현재 클라이언트가 사용하는 프록시 풀 (재설정 시 상태 확인 중지)
+var activeProxyPool *proxyPool
+
+// HTTP 클라이언트 설정
+var client = mustNewHTTPClient(DefaultHTTPConfig)
+
+func (c HTTPConfig) withDefaults() HTTPConfig {
+	if c.Timeout <= 0 {
+		c.Timeout = 10 * time.Second
+	}
+	if c.DialTimeout <= 0 {
+		c.DialTimeout = 5 * time.Second
+	}
+	if c.TLSHandshakeTimeout <= 0 {
+		c.TLSHandshakeTimeout = 5 * time.Second
+	}
+	if c.MaxIdleConns <= 0 {
+		c.MaxIdleConns = 100
+	}
+	if c.MaxIdleConnsPerHost <= 0 {
+		c.MaxIdleConnsPerHost = 10
+	}
+	if c.IdleConnTimeout <= 0 {
+		c.IdleConnTimeout = 90 * time.Second
+	}
+	if c.ProxyCheckInterval <= 0 {
+		c.ProxyCheckInterval = time.Minute
+	}
+	if c.ProxyMaxFailures <= 0 {
+		c.ProxyMaxFailures = 3
+	}
+	if len(c.UserAgents) == 0 {
+		c.UserAgents = DefaultUserAgentProfiles
+	}
+	return c
+}
+
+// 프록시 하나(또는 직접 연결)에 대한 Transport
+func newTransport(cfg HTTPConfig, proxy *url.URL) *http.Transport {
+	t := &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   cfg.DialTimeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSClientConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		},
+		TLSHandshakeTimeout:   cfg.TLSHandshakeTimeout,
+		ResponseHeaderTimeout: cfg.ResponseHeaderTimeout,
+		MaxIdleConns:          cfg.MaxIdleConns,
+		MaxIdleConnsPerHost:   cfg.MaxIdleConnsPerHost,
+		MaxConnsPerHost:       cfg.MaxConnsPerHost,
+		IdleConnTimeout:       cfg.IdleConnTimeout,
+		ForceAttemptHTTP2:     !cfg.DisableHTTP2,
+	}
+	if cfg.DisableHTTP2 {
+		// 비어 있는 TLSNextProto는 ALPN으로 h2를 협상하지 않음
+		t.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+	if proxy != nil {
+		// net/http는 http, https, socks5 프록시 주소를 모두 지원
+		t.Proxy = http.ProxyURL(proxy)
+	}
+	return t
+}
+
+// NewHTTPClient 설정으로 HTTP 클라이언트 생성
+func NewHTTPClient(cfg HTTPConfig) (*http.Client, error) {
+	c, _, err := newHTTPClient(cfg)
+	return c, err
+}
+
+func newHTTPClient(cfg HTTPConfig) (*http.Client, *proxyPool, error) {
+	cfg = cfg.withDefaults()
+
+	var next http.RoundTripper
+	var pool *proxyPool
+	switch len(cfg.Proxies) {
+	case 0:
+		next = newTransport(cfg, nil)
+	default:
+		var err error
+		pool, err = newProxyPool(cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		next = pool
+	}
+
+	return &http.Client{
+		Transport: &headerTransport{next: next, profiles: cfg.UserAgents},
+		Timeout:   cfg.Timeout,
+	}, pool, nil
+}
+
+func mustNewHTTPClient(cfg HTTPConfig) *http.Client {
+	c, err := NewHTTPClient(cfg)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// ConfigureHTTP 설정으로 클라이언트를 만들어 모든 요청에 사용 (프록시 상태 확인 시작)
+func ConfigureHTTP(cfg HTTPConfig) error {
+	c, pool, err := newHTTPClient(cfg)
+	if err != nil {
+		return err
+	}
+	if activeProxyPool != nil {
+		activeProxyPool.Stop()
+	}
+	activeProxyPool = pool
+	if pool != nil {
+		pool.Start()
+	}
+	SetHTTPClient(c)
+	return nil
+}
+
+// 모든 요청에 브라우저 프로필 헤더를 붙이는 Transport (요청에 이미 있는 헤더는 유지)
+type headerTransport struct {
+	next     http.RoundTripper
+	profiles []UserAgentProfile
+
+	mu   sync.Mutex
+	turn int
+}
+
+// 다음 프로필 (요청마다 순서대로 돌아가며 사용)
+func (t *headerTransport) profile() UserAgentProfile {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p := t.profiles[t.turn%len(t.profiles)]
+	t.turn++
+	return p
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTripper는 원래 요청을 수정하면 안 되므로 복사본에 헤더 설정
+	req = req.Clone(req.Context())
+	p := t.profile()
+
+	setDefault := func(key, value string) {
+		if value != "" && req.Header.Get(key) == "" {
+			req.Header.Set(key, value)
+		}
+	}
+	setDefault("User-Agent", p.UserAgent)
+	setDefault("Accept-Language", p.AcceptLanguage)
+	setDefault("Accept", "text/html,application/json,application/xhtml+xml;q=0.9,*/*;q=0.8")
+	setDefault("Sec-CH-UA", p.SecCHUA)
+	if p.SecCHUA != "" {
+		setDefault("Sec-CH-UA-Mobile", "?0")
+		setDefault("Sec-CH-UA-Platform", p.Platform)
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+// HTTPConfigFromEnv 환경 변수에서 HTTP 설정 읽기 (기본 설정에 HTTPConfigWithEnv 적용)
+func HTTPConfigFromEnv() (HTTPConfig, error) {
+	return HTTPConfigWithEnv(DefaultHTTPConfig)
+}
+
+// HTTPConfigWithEnv 설정된 환경 변수만 base에 덮어씀 (설정 파일 값보다 환경 변수 우선)
+//
+//	NAVER_PROXIES            쉼표로 구분한 프록시 주소 (http://, https://, socks5://)
+//	NAVER_PROXY_CHECK_URL    프록시 상태 확인 주소
+//	NAVER_USER_AGENT_FILE    한 줄에 하나씩 User-Agent가 적힌 파일
+//	NAVER_HTTP_TIMEOUT       요청 제한 시간 (예: 15s)
+//	NAVER_HTTP_MAX_CONNS     호스트당 최대 연결 수
+//	NAVER_HTTP2              0이면 HTTP/1.1만 사용
+func HTTPConfigWithEnv(base HTTPConfig) (HTTPConfig, error) {
+	cfg := base
+
+	if v := os.Getenv("NAVER_PROXIES"); v != "" {
+		cfg.Proxies = nil
+		for _, p := range strings.Split(v, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				cfg.Proxies = append(cfg.Proxies, p)
+			}
+		}
+	}
+	if v := os.Getenv("NAVER_PROXY_CHECK_URL"); v != "" {
+		cfg.ProxyCheckURL = v
+	}
+
+	if path := os.Getenv("NAVER_USER_AGENT_FILE"); path != "" {
+		profiles, err := LoadUserAgentFile(path)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.UserAgents = profiles
+	}
+
+	if v := os.Getenv("NAVER_HTTP_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("NAVER_HTTP_TIMEOUT 형식 오류: %v", err)
+		}
+		cfg.Timeout = d
+	}
+	if v := os.Getenv("NAVER_HTTP_MAX_CONNS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("NAVER_HTTP_MAX_CONNS 형식 오류: %v", err)
+		}
+		cfg.MaxConnsPerHost = n
+	}
+	if os.Getenv("NAVER_HTTP2") == "0" {
+		cfg.DisableHTTP2 = true
+	}
+	return cfg, nil
+}
+
+// LoadUserAgentFile User-Agent 목록 파일 읽기 (빈 줄과 #으로 시작하는 줄은 무시)
+func LoadUserAgentFile(path string) ([]UserAgentProfile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("User-Agent 파일 열기 실패: %v", err)
+	}
+	defer f.Close()
+
+	var profiles []UserAgentProfile
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		profiles = append(profiles, UserAgentProfile{
+			UserAgent:      line,
+			AcceptLanguage: "ko-KR,ko;q=0.9,en-US;q=0.8,en;q=0.7",
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("User-Agent 파일 읽기 실패: %v", err)
+	}
+	if len(profiles) == 0 {
+		return nil, fmt.Errorf("User-Agent 파일이 비어 있습니다: %s", path)
+	}
+	return profiles, nil
+}
@@ -0,0 +1,207 @@
+package crawling
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// 받은 요청 헤더를 기록하는 서버
+func headerRecorder(t *testing.T) (*httptest.Server, func() []http.Header) {
+	t.Helper()
+	var mu sync.Mutex
+	var headers []http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers = append(headers, r.Header.Clone())
+		mu.Unlock()
+		io.WriteString(w, "ok")
+	}))
+	t.Cleanup(srv.Close)
+	return srv, func() []http.Header {
+		mu.Lock()
+		defer mu.Unlock()
+		return headers
+	}
+}
+
+func get(t *testing.T, c *http.Client, req *http.Request) {
+	t.Helper()
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+func TestHeaderTransportRotatesProfiles(t *testing.T) {
+	srv, headers := headerRecorder(t)
+
+	profiles := []UserAgentProfile{
+		{UserAgent: "ua-chrome", AcceptLanguage: "ko-KR", SecCHUA: `"Chromium";v="1"`, Platform: `"macOS"`},
+		{UserAgent: "ua-firefox", AcceptLanguage: "ko"},
+	}
+	c, err := NewHTTPClient(HTTPConfig{UserAgents: profiles})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequest("GET", srv.URL, nil)
+		get(t, c, req)
+	}
+	req, _ := http.NewRequest("GET", srv.URL, nil)
+	req.Header.Set("User-Agent", "explicit")
+	get(t, c, req)
+	if req.Header.Get("Accept-Language") != "" {
+		t.Error("original request must not be modified")
+	}
+
+	got := headers()
+	wantUA := []string{"ua-chrome", "ua-firefox", "ua-chrome", "explicit"}
+	for i, want := range wantUA {
+		if ua := got[i].Get("User-Agent"); ua != want {
+			t.Errorf("request %d User-Agent = %q, want %q", i, ua, want)
+		}
+	}
+	if got[0].Get("Sec-CH-UA-Platform") != `"macOS"` || got[0].Get("Accept-Language") != "ko-KR" {
+		t.Errorf("chrome profile headers = %v", got[0])
+	}
+	if got[1].Get("Sec-CH-UA") != "" {
+		t.Errorf("firefox profile must not send client hints: %v", got[1])
+	}
+}
+
+func TestProxyPool(t *testing.T) {
+	target, _ := headerRecorder(t)
+
+	// http 프록시는 절대 URL로 요청을 받으므로 그대로 대상 서버로 전달
+	var mu sync.Mutex
+	proxied := 0
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		proxied++
+		mu.Unlock()
+		resp, err := http.Get(r.URL.String())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+		io.Copy(w, resp.Body)
+	}))
+	defer good.Close()
+
+	bad := httptest.NewServer(http.NotFoundHandler())
+	badURL := bad.URL
+	bad.Close() // 연결이 거부되는 프록시
+
+	pool, err := newProxyPool(HTTPConfig{
+		Proxies:          []string{badURL, good.URL},
+		ProxyCheckURL:    target.URL,
+		ProxyMaxFailures: 1,
+	}.withDefaults())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &http.Client{Transport: pool}
+
+	if resp, err := c.Get(target.URL); err == nil {
+		resp.Body.Close()
+		t.Fatal("expected first request through the dead proxy to fail")
+	}
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequest("GET", target.URL, nil)
+		get(t, c, req)
+	}
+	if proxied != 3 {
+		t.Errorf("good proxy handled %d requests, want 3", proxied)
+	}
+	if pool.healthyCount() != 1 {
+		t.Errorf("healthy proxies = %d, want 1", pool.healthyCount())
+	}
+
+	// 상태 확인에서도 죽은 프록시는 계속 제외
+	pool.checkAll()
+	if pool.entries[0].healthy || !pool.entries[1].healthy {
+		t.Errorf("after health check: bad=%v good=%v", pool.entries[0].healthy, pool.entries[1].healthy)
+	}
+}
+
+func TestNewHTTPClientProxySchemes(t *testing.T) {
+	for _, proxy := range []string{"http://127.0.0.1:8080", "https://user:pw@proxy.example:443", "socks5://127.0.0.1:1080"} {
+		if _, err := NewHTTPClient(HTTPConfig{Proxies: []string{proxy}}); err != nil {
+			t.Errorf("%s: %v", proxy, err)
+		}
+	}
+	if _, err := NewHTTPClient(HTTPConfig{Proxies: []string{"ftp://127.0.0.1:21"}}); err == nil {
+		t.Error("expected error for unsupported proxy scheme")
+	}
+}
+
+func TestHTTPConfigFromEnv(t *testing.T) {
+	uaFile := filepath.Join(t.TempDir(), "agents.txt")
+	os.WriteFile(uaFile, []byte("# 주석\nagent-one\n\nagent-two\n"), 0644)
+
+	t.Setenv("NAVER_PROXIES", "http://a:1, socks5://b:2")
+	t.Setenv("NAVER_USER_AGENT_FILE", uaFile)
+	t.Setenv("NAVER_HTTP_TIMEOUT", "15s")
+	t.Setenv("NAVER_HTTP2", "0")
+
+	cfg, err := HTTPConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Proxies) != 2 || cfg.Proxies[1] != "socks5://b:2" {
+		t.Errorf("Proxies = %v", cfg.Proxies)
+	}
+	if len(cfg.UserAgents) != 2 || cfg.UserAgents[1].UserAgent != "agent-two" {
+		t.Errorf("UserAgents = %v", cfg.UserAgents)
+	}
+	if cfg.Timeout != 15*time.Second || !cfg.DisableHTTP2 {
+		t.Errorf("cfg = %+v", cfg)
+	}
+
+	t.Setenv("NAVER_HTTP_TIMEOUT", "soon")
+	if _, err := HTTPConfigFromEnv(); err == nil {
+		t.Error("expected error for invalid timeout")
+	}
+}
+
+func TestHTTPConfigWithEnv(t *testing.T) {
+	base := HTTPConfig{
+		Timeout:         20 * time.Second,
+		MaxConnsPerHost: 2,
+		Proxies:         []string{"http://file:1"},
+		ProxyCheckURL:   "https://check.example",
+	}
+
+	// 설정하지 않은 환경 변수는 base 값 유지
+	cfg, err := HTTPConfigWithEnv(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(cfg, base) {
+		t.Errorf("cfg = %+v, want %+v", cfg, base)
+	}
+
+	t.Setenv("NAVER_PROXIES", "http://env:1")
+	t.Setenv("NAVER_HTTP_MAX_CONNS", "8")
+	cfg, err = HTTPConfigWithEnv(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Proxies) != 1 || cfg.Proxies[0] != "http://env:1" || cfg.MaxConnsPerHost != 8 {
+		t.Errorf("env override cfg = %+v", cfg)
+	}
+	if cfg.Timeout != 20*time.Second || cfg.ProxyCheckURL != "https://check.example" {
+		t.Errorf("base values lost: %+v", cfg)
+	}
+}
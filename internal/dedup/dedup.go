@@ -0,0 +1,199 @@
+// Package dedup 실행을 넘어 (출처, ID) 기준 중복을 걸러내고, 본문 지문(SimHash)으로 유사 중복 게시글을 같은 클러스터로 묶는 인덱스
+package dedup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// 기본 설정
+const (
+	DefaultThreshold = 6  // 유사 중복으로 볼 최대 해밍 거리 (구간 검색 때문에 7을 넘으면 놓칠 수 있음)
+	DefaultMinRunes  = 50 // 이보다 짧은 본문은 지문을 만들지 않음 (짧은 글은 우연히 비슷해지기 쉬움)
+)
+
+// Record 인덱스에 저장된 게시글 하나
+type Record struct {
+	Key         string    `json:"key"` // 출처:ID
+	Fingerprint uint64    `json:"fingerprint,string,omitempty"`
+	HasPrint    bool      `json:"has_fingerprint"`
+	ClusterID   string    `json:"cluster_id,omitempty"`
+	FirstSeen   time.Time `json:"first_seen"`
+}
+
+// Result 게시글을 인덱스에 추가한 결과
+type Result struct {
+	Duplicate       bool   // 같은 (출처, ID)를 이전에 이미 봤음 (결과에서 제외할 대상)
+	ClusterID       string // 유사 중복 클러스터 ID (지문이 없으면 빈 문자열)
+	NearDuplicateOf string // 유사 중복으로 판단한 기존 게시글의 키
+}
+
+// Index 중복 확인 인덱스 (여러 고루틴에서 함께 사용 가능)
+type Index struct {
+	Threshold int
+	MinRunes  int
+
+	path    string
+	mu      sync.Mutex
+	records map[string]*Record
+	order   []string                      // 추가 순서 (같은 거리면 먼저 본 게시글 우선)
+	bands   [bandCount]map[uint8][]string // 구간 값 -> 키
+}
+
+// New 빈 인덱스
+func New() *Index {
+	ix := &Index{
+		Threshold: DefaultThreshold,
+		MinRunes:  DefaultMinRunes,
+		records:   make(map[string]*Record),
+	}
+	for i := range ix.bands {
+		ix.bands[i] = make(map[uint8][]string)
+	}
+	return ix
+}
+
+// 저장 파일 형식
+type indexFile struct {
+	Version int       `json:"version"`
+	Records []*Record `json:"records"`
+}
+
+// Load 파일에서 인덱스 읽기 (파일이 없으면 빈 인덱스, Save는 같은 파일에 저장)
+func Load(path string) (*Index, error) {
+	ix := New()
+	ix.path = path
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ix, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("중복 인덱스 읽기 실패: %v", err)
+	}
+
+	var f indexFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("중복 인덱스 파싱 실패: %v", err)
+	}
+	sort.SliceStable(f.Records, func(i, j int) bool {
+		return f.Records[i].FirstSeen.Before(f.Records[j].FirstSeen)
+	})
+	for _, r := range f.Records {
+		ix.insert(r)
+	}
+	return ix, nil
+}
+
+// Save Load한 파일에 인덱스 저장
+func (ix *Index) Save() error {
+	if ix.path == "" {
+		return fmt.Errorf("저장할 파일 경로가 없습니다 (Load로 만든 인덱스만 저장 가능)")
+	}
+	return ix.SaveTo(ix.path)
+}
+
+// SaveTo 인덱스를 파일에 저장 (임시 파일에 쓴 뒤 교체)
+func (ix *Index) SaveTo(path string) error {
+	ix.mu.Lock()
+	f := indexFile{Version: 1}
+	for _, key := range ix.order {
+		f.Records = append(f.Records, ix.records[key])
+	}
+	data, err := json.MarshalIndent(f, "", "  ")
+	ix.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("JSON 변환 실패: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("디렉토리 생성 실패: %v", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("중복 인덱스 저장 실패: %v", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("중복 인덱스 저장 실패: %v", err)
+	}
+	return nil
+}
+
+// Len 인덱스에 있는 게시글 수
+func (ix *Index) Len() int {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	return len(ix.records)
+}
+
+// Has 같은 (출처, ID)를 이미 봤는지 (인덱스는 바꾸지 않음, 상세 요청 전에 건너뛸 때 사용)
+func (ix *Index) Has(source, id string) bool {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	_, ok := ix.records[source+":"+id]
+	return ok
+}
+
+func (ix *Index) insert(r *Record) {
+	ix.records[r.Key] = r
+	ix.order = append(ix.order, r.Key)
+	if r.HasPrint {
+		for i := range ix.bands {
+			b := band(r.Fingerprint, i)
+			ix.bands[i][b] = append(ix.bands[i][b], r.Key)
+		}
+	}
+}
+
+// 지문이 가장 가까운 기존 게시글 (임계값 이내)
+func (ix *Index) nearest(fp uint64) *Record {
+	var best *Record
+	bestDistance := ix.Threshold + 1
+	seen := make(map[string]bool)
+	for i := range ix.bands {
+		for _, key := range ix.bands[i][band(fp, i)] {
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			r := ix.records[key]
+			d := Distance(fp, r.Fingerprint)
+			if d < bestDistance || (d == bestDistance && best != nil && r.FirstSeen.Before(best.FirstSeen)) {
+				best, bestDistance = r, d
+			}
+		}
+	}
+	return best
+}
+
+// Add 게시글을 인덱스에 추가하고 중복 여부와 클러스터 반환
+// source는 "blog", "cafe" 같은 출처, id는 출처 안에서 유일한 ID
+func (ix *Index) Add(source, id, text string) Result {
+	key := source + ":" + id
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	if r, ok := ix.records[key]; ok {
+		return Result{Duplicate: true, ClusterID: r.ClusterID}
+	}
+
+	r := &Record{Key: key, FirstSeen: time.Now()}
+	var result Result
+	if fp, ok := Fingerprint(text, ix.MinRunes); ok {
+		r.Fingerprint, r.HasPrint = fp, true
+		r.ClusterID = key
+		if near := ix.nearest(fp); near != nil {
+			r.ClusterID = near.ClusterID
+			result.NearDuplicateOf = near.Key
+		}
+		result.ClusterID = r.ClusterID
+	}
+	ix.insert(r)
+	return result
+}
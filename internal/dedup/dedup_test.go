@@ -0,0 +1,103 @@
+package dedup
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+const (
+	original = "제주도에 다녀왔습니다. 첫날은 성산일출봉에 올라 해돋이를 보고, 오후에는 우도에 들어가 땅콩 아이스크림을 먹었습니다. " +
+		"둘째 날은 한라산 영실 코스를 걸었는데 날씨가 정말 좋아서 백록담까지 선명하게 보였어요. 마지막 날에는 동문시장에서 " +
+		"귤과 오메기떡을 잔뜩 사 왔습니다. 다음에는 가을에 다시 가 보고 싶네요."
+	// 스크랩하면서 출처 문구가 붙고 띄어쓰기와 문장부호가 조금 바뀐 글
+	scrapped = "[스크랩] 제주도에 다녀왔습니다! 첫날은 성산일출봉에 올라 해돋이를 보고 오후에는 우도에 들어가 땅콩 아이스크림을 먹었습니다. " +
+		"둘째 날은 한라산 영실 코스를 걸었는데 날씨가 정말 좋아서 백록담까지 선명하게 보였어요. 마지막 날에는 동문시장에서 " +
+		"귤과 오메기떡을 잔뜩 사 왔습니다. 다음에는 가을에 다시 가 보고 싶네요"
+	unrelated = "오늘은 집에서 김치찌개를 끓였습니다. 돼지고기 앞다리살을 먼저 볶고 잘 익은 김치를 넣어 충분히 볶은 뒤 " +
+		"쌀뜨물을 부어 30분 정도 끓였어요. 두부와 대파를 마지막에 넣고 한소끔 더 끓이면 완성입니다. 밥 두 공기는 기본이네요."
+)
+
+func TestFingerprint(t *testing.T) {
+	a, ok := Fingerprint(original, DefaultMinRunes)
+	if !ok {
+		t.Fatal("expected fingerprint for long text")
+	}
+	b, _ := Fingerprint(scrapped, DefaultMinRunes)
+	c, _ := Fingerprint(unrelated, DefaultMinRunes)
+
+	if d := Distance(a, b); d > DefaultThreshold {
+		t.Errorf("distance(original, scrapped) = %d, want <= %d", d, DefaultThreshold)
+	}
+	if d := Distance(a, c); d <= DefaultThreshold {
+		t.Errorf("distance(original, unrelated) = %d, want > %d", d, DefaultThreshold)
+	}
+
+	if _, ok := Fingerprint("짧은 글", DefaultMinRunes); ok {
+		t.Error("short text must not get a fingerprint")
+	}
+}
+
+func TestIndexAdd(t *testing.T) {
+	ix := New()
+
+	first := ix.Add("blog", "allminwon/1", original)
+	if first.Duplicate || first.ClusterID != "blog:allminwon/1" || first.NearDuplicateOf != "" {
+		t.Errorf("first = %+v", first)
+	}
+
+	near := ix.Add("cafe", "12345/1001", scrapped)
+	if near.Duplicate || near.ClusterID != "blog:allminwon/1" || near.NearDuplicateOf != "blog:allminwon/1" {
+		t.Errorf("near duplicate = %+v", near)
+	}
+
+	other := ix.Add("blog", "allminwon/2", unrelated)
+	if other.ClusterID != "blog:allminwon/2" || other.NearDuplicateOf != "" {
+		t.Errorf("unrelated = %+v", other)
+	}
+
+	again := ix.Add("cafe", "12345/1001", "본문이 바뀌어도 같은 ID면 중복")
+	if !again.Duplicate || again.ClusterID != "blog:allminwon/1" {
+		t.Errorf("exact duplicate = %+v", again)
+	}
+
+	short := ix.Add("blog", "allminwon/3", "사진만 있는 글")
+	if short.Duplicate || short.ClusterID != "" {
+		t.Errorf("short = %+v", short)
+	}
+	if ix.Len() != 4 {
+		t.Errorf("Len = %d, want 4", ix.Len())
+	}
+	if !ix.Has("cafe", "12345/1001") || ix.Has("cafe", "allminwon/1") || ix.Len() != 4 {
+		t.Errorf("Has: want (source, id) lookup without adding")
+	}
+}
+
+func TestIndexPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index", "dedup.json")
+
+	ix, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load missing file: %v", err)
+	}
+	ix.Add("blog", "allminwon/1", original)
+	ix.Add("blog", "allminwon/2", unrelated)
+	if err := ix.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	// 다음 실행: 이미 본 게시글은 중복, 스크랩 글은 이전 실행의 클러스터로
+	next, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if r := next.Add("blog", "allminwon/1", original); !r.Duplicate {
+		t.Errorf("seen post = %+v, want duplicate", r)
+	}
+	if r := next.Add("cafe", "12345/1001", scrapped); r.Duplicate || r.ClusterID != "blog:allminwon/1" {
+		t.Errorf("scrap = %+v", r)
+	}
+
+	if err := New().Save(); err == nil {
+		t.Error("expected error saving index without path")
+	}
+}
@@ -0,0 +1,66 @@
+package dedup
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"strings"
+	"unicode"
+)
+
+// 지문을 만들 때 쓰는 글자 단위 n-gram 길이
+const shingleSize = 3
+
+// 글자와 숫자만 남기고 소문자로 (공백, 문장부호, 스크랩 안내 문구의 기호 차이는 무시)
+func normalize(text string) []rune {
+	var runes []rune
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			runes = append(runes, r)
+		}
+	}
+	return runes
+}
+
+// Fingerprint 본문의 SimHash 지문 (정규화한 글자 수가 minRunes보다 적으면 false)
+func Fingerprint(text string, minRunes int) (uint64, bool) {
+	runes := normalize(text)
+	if len(runes) < minRunes || len(runes) < shingleSize {
+		return 0, false
+	}
+
+	var weights [64]int
+	h := fnv.New64a()
+	for i := 0; i+shingleSize <= len(runes); i++ {
+		h.Reset()
+		h.Write([]byte(string(runes[i : i+shingleSize])))
+		sum := h.Sum64()
+		for bit := 0; bit < 64; bit++ {
+			if sum&(1<<bit) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	var fp uint64
+	for bit, w := range weights {
+		if w > 0 {
+			fp |= 1 << bit
+		}
+	}
+	return fp, true
+}
+
+// Distance 두 지문의 해밍 거리 (다른 비트 수)
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// 지문을 8비트씩 8개 구간으로 나눔
+// 해밍 거리가 7 이하인 두 지문은 최소 한 구간이 완전히 같으므로 같은 구간 값끼리만 비교하면 됨
+const bandCount = 8
+
+func band(fp uint64, i int) uint8 {
+	return uint8(fp >> (8 * i))
+}
@@ -0,0 +1,233 @@
+// Package redact 수집한 제목/본문/댓글에서 개인정보(전화번호, 이메일, 주민등록번호, 계좌번호, 주소)를 가리고,
+// 작성자 닉네임/회원 키를 솔트를 넣은 해시로 바꿔 같은 작성자끼리는 계속 같은 가명이 되도록 하는 단계
+package redact
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// 가린 자리에 넣는 표시
+const (
+	MaskEmail   = "[이메일]"
+	MaskRRN     = "[주민등록번호]"
+	MaskPhone   = "[전화번호]"
+	MaskAccount = "[계좌번호]"
+	MaskAddress = "[주소]"
+)
+
+// 가명 접두사와 해시 길이 (16진수 글자 수)
+const (
+	pseudonymPrefix = "user_"
+	pseudonymLength = 12
+)
+
+type rule struct {
+	kind     string
+	pattern  *regexp.Regexp
+	mask     string
+	boundary bool              // 앞뒤가 숫자/영문자면 더 긴 값(주소, 이미지 파일명 등)의 일부이므로 건너뜀
+	valid    func(string) bool // 추가 확인 (nil이면 항상 가림)
+}
+
+// 적용 순서가 중요함: 주민등록번호를 전화번호/계좌번호보다, 전화번호를 계좌번호보다 먼저 가림
+var rules = []rule{
+	{
+		kind:    "email",
+		pattern: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`),
+		mask:    MaskEmail,
+	},
+	{
+		kind:     "rrn",
+		pattern:  regexp.MustCompile(`\d{6}\s?-?\s?[1-8]\d{6}`),
+		mask:     MaskRRN,
+		boundary: true,
+		valid:    validRRN,
+	},
+	{
+		kind: "phone",
+		pattern: regexp.MustCompile(`01[016789][-. ]?\d{3,4}[-. ]?\d{4}` +
+			`|\(?0(?:2|[3-6][1-5]|70)\)?[-. )]?\d{3,4}[-. ]?\d{4}` +
+			`|1[5-9]\d{2}-\d{4}`),
+		mask:     MaskPhone,
+		boundary: true,
+	},
+	{
+		kind:     "account",
+		pattern:  regexp.MustCompile(`\d{2,6}-\d{2,6}-\d{2,7}(?:-\d{1,3})?`),
+		mask:     MaskAccount,
+		boundary: true,
+		valid:    validAccount,
+	},
+	{
+		// 도로명 주소 (시/군/구 + 도로명 + 건물번호) 또는 지번 주소 (시/군/구 + 동/리 + 번지), 동/호수까지
+		kind: "address",
+		pattern: regexp.MustCompile(`(?:[가-힣]+도\s+)?[가-힣]+(?:시|군|구)\s+(?:[가-힣]+(?:구|읍|면)\s+)?` +
+			`(?:[가-힣0-9]+(?:로|길)\s*\d+(?:-\d+)?(?:번길\s*\d+(?:-\d+)?)?` +
+			`|[가-힣0-9]+(?:동|리|가)\s+\d+(?:-\d+)?(?:번지)?)` +
+			`(?:,?\s*\d+동)?(?:\s*\d+호)?`),
+		mask: MaskAddress,
+	},
+}
+
+// 앞 6자리가 생년월일이고 (하이픈이 없으면) 월/일이 맞는 경우만 주민등록번호로 봄
+func validRRN(s string) bool {
+	digits := onlyDigits(s)
+	if len(digits) != 13 {
+		return false
+	}
+	month := (digits[2]-'0')*10 + (digits[3] - '0')
+	day := (digits[4]-'0')*10 + (digits[5] - '0')
+	return month >= 1 && month <= 12 && day >= 1 && day <= 31
+}
+
+// 날짜(2024-04-25)나 짧은 번호는 빼고, 은행 계좌번호 길이(10~14자리)만
+func validAccount(s string) bool {
+	n := len(onlyDigits(s))
+	return n >= 10 && n <= 14
+}
+
+func onlyDigits(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func isAlnum(b byte) bool {
+	return (b >= '0' && b <= '9') || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
+
+// Redactor 개인정보 가리기/작성자 가명 처리기 (여러 고루틴에서 함께 사용 가능)
+type Redactor struct {
+	salt []byte
+
+	mu     sync.Mutex
+	counts map[string]int // 종류별 가린 개수
+}
+
+// New 개인정보 가리기 처리기
+// salt가 비어 있으면 작성자는 그대로 두고, 있으면 작성자를 salt로 만든 가명으로 바꿈
+// (솔트 없는 해시는 닉네임 사전으로 되돌릴 수 있으므로 가명 처리에는 솔트가 꼭 필요함)
+func New(salt string) *Redactor {
+	return &Redactor{
+		salt:   []byte(salt),
+		counts: make(map[string]int),
+	}
+}
+
+// NewIf enabled면 New(salt), 아니면 nil (가리지 않음)
+func NewIf(enabled bool, salt string) *Redactor {
+	if !enabled {
+		return nil
+	}
+	return New(salt)
+}
+
+// Pseudonymizes 작성자 가명 처리 여부
+func (r *Redactor) Pseudonymizes() bool {
+	return len(r.salt) > 0
+}
+
+// Mode 가리는 범위 설명 (시작 로그용)
+func (r *Redactor) Mode() string {
+	if r.Pseudonymizes() {
+		return "본문 개인정보와 작성자 가명 처리"
+	}
+	return "본문 개인정보만 (솔트가 없어 작성자는 그대로)"
+}
+
+// Text 문자열에서 개인정보를 찾아 가림
+func (r *Redactor) Text(s string) string {
+	if s == "" {
+		return s
+	}
+	for _, rl := range rules {
+		s = r.apply(rl, s)
+	}
+	return s
+}
+
+func (r *Redactor) apply(rl rule, s string) string {
+	matches := rl.pattern.FindAllStringIndex(s, -1)
+	if len(matches) == 0 {
+		return s
+	}
+
+	var b strings.Builder
+	last, n := 0, 0
+	for _, m := range matches {
+		start, end := m[0], m[1]
+		if rl.boundary && ((start > 0 && isAlnum(s[start-1])) || (end < len(s) && isAlnum(s[end]))) {
+			continue
+		}
+		if rl.valid != nil && !rl.valid(s[start:end]) {
+			continue
+		}
+		b.WriteString(s[last:start])
+		b.WriteString(rl.mask)
+		last = end
+		n++
+	}
+	if n == 0 {
+		return s
+	}
+	b.WriteString(s[last:])
+
+	r.mu.Lock()
+	r.counts[rl.kind] += n
+	r.mu.Unlock()
+	return b.String()
+}
+
+// Writer 작성자 닉네임/회원 키/블로그 ID를 가명으로 바꿈 (솔트가 없거나 빈 값이면 그대로)
+// 같은 솔트에서는 같은 값이 항상 같은 가명이 되므로 실행/파일이 달라도 작성자별로 묶을 수 있음
+func (r *Redactor) Writer(id string) string {
+	if id == "" || !r.Pseudonymizes() {
+		return id
+	}
+	mac := hmac.New(sha256.New, r.salt)
+	mac.Write([]byte(id))
+	sum := hex.EncodeToString(mac.Sum(nil))
+
+	r.mu.Lock()
+	r.counts["writer"]++
+	r.mu.Unlock()
+	return pseudonymPrefix + sum[:pseudonymLength]
+}
+
+// Counts 지금까지 종류별로 가린 개수 (email, rrn, phone, account, address, writer)
+func (r *Redactor) Counts() map[string]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	counts := make(map[string]int, len(r.counts))
+	for k, v := range r.counts {
+		counts[k] = v
+	}
+	return counts
+}
+
+// Summary 종류별 가린 개수를 "email=1, phone=3" 형태로
+func (r *Redactor) Summary() string {
+	counts := r.Counts()
+	kinds := make([]string, 0, len(counts))
+	for k := range counts {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+
+	parts := make([]string, 0, len(kinds))
+	for _, k := range kinds {
+		parts = append(parts, k+"="+strconv.Itoa(counts[k]))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,70 @@
+package redact
+
+import "testing"
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"mobile", "연락처 010-1234-5678로 문자 주세요", "연락처 [전화번호]로 문자 주세요"},
+		{"mobile without hyphen", "01012345678", "[전화번호]"},
+		{"landline", "매장 문의 (02)345-6789, 031-123-4567", "매장 문의 [전화번호], [전화번호]"},
+		{"representative number", "고객센터 1588-1234", "고객센터 [전화번호]"},
+		{"email", "메일은 min.won+cafe@naver.com 입니다", "메일은 [이메일] 입니다"},
+		{"rrn", "주민번호 900101-1234567 유출 주의", "주민번호 [주민등록번호] 유출 주의"},
+		{"rrn without hyphen", "9001011234567", "[주민등록번호]"},
+		{"not rrn", "주문번호 9913011234567", "주문번호 9913011234567"},
+		{"account", "입금 계좌 국민 123456-01-123456 (홍길동)", "입금 계좌 국민 [계좌번호] (홍길동)"},
+		{"date is not account", "2024-04-25에 만나요", "2024-04-25에 만나요"},
+		{"road address", "서울특별시 강남구 테헤란로 123, 101동 202호로 오세요", "[주소]로 오세요"},
+		{"road address with province", "경기도 성남시 분당구 판교역로 235 근처", "[주소] 근처"},
+		{"jibun address", "제주시 연동 273-5번지 앞", "[주소] 앞"},
+		{"digits inside longer token", "https://postfiles.pstatic.net/20240425_010123456789.jpg", "https://postfiles.pstatic.net/20240425_010123456789.jpg"},
+		{"plain text", "제주도에 다녀왔습니다. 날씨가 정말 좋았어요!", "제주도에 다녀왔습니다. 날씨가 정말 좋았어요!"},
+		{"html", `<p><span>문의: 010-9876-5432</span></p>`, `<p><span>문의: [전화번호]</span></p>`},
+	}
+
+	r := New("")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Text(tt.in); got != tt.want {
+				t.Errorf("Text(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriter(t *testing.T) {
+	if got := New("").Writer("민원이"); got != "민원이" {
+		t.Errorf("without salt Writer = %q, want unchanged", got)
+	}
+
+	r := New("team-salt")
+	a, b := r.Writer("민원이"), r.Writer("민원이")
+	if a != b {
+		t.Errorf("pseudonym not stable: %q != %q", a, b)
+	}
+	if len(a) != len(pseudonymPrefix)+pseudonymLength || a[:len(pseudonymPrefix)] != pseudonymPrefix {
+		t.Errorf("pseudonym = %q", a)
+	}
+	if r.Writer("여행자") == a {
+		t.Error("different writers must get different pseudonyms")
+	}
+	if New("other-salt").Writer("민원이") == a {
+		t.Error("different salts must give different pseudonyms")
+	}
+	if r.Writer("") != "" {
+		t.Error("empty writer must stay empty")
+	}
+}
+
+func TestSummary(t *testing.T) {
+	r := New("salt")
+	r.Text("010-1234-5678, 02-123-4567, a@b.co")
+	r.Writer("민원이")
+	if got, want := r.Summary(), "email=1, phone=2, writer=1"; got != want {
+		t.Errorf("Summary = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,97 @@
+// Package scheduler 설정 파일(config.Config)의 소스 중 schedule이 있는 블로그/카페 게시판/카페 검색을 cron 일정에 맞춰 실행하는 데몬
+// 소스마다 중복 인덱스를 유지해 새 게시글만 수집하고, 같은 소스가 겹쳐 실행되지 않게 하며 실행 기록을 남김
+//
+// 설정 파일 예시 (naverCrawl과 같은 YAML에 scheduler 항목과 소스별 schedule 추가)
+//
+//	scheduler:
+//	  state_dir: scheduler_state
+//	  max_concurrent: 2
+//	output:
+//	  redact: true
+//	credentials:
+//	  cookie_file: cookies.txt
+//	sources:
+//	  - name: minwon-blog
+//	    type: blog
+//	    blog_id: allminwon
+//	    max_pages: 3
+//	    schedule: "0 */6 * * *"
+//	  - name: review-board
+//	    type: cafe
+//	    cafe_id: "12345"
+//	    board_id: "7"
+//	    schedule: "30 9 * * mon-fri"
+//	  - name: battery-search
+//	    type: search
+//	    cafe_id: "12345"
+//	    query: 배터리
+//	    schedule: "@daily"
+package scheduler
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"naverCrawler/internal/config"
+)
+
+// 기본값
+const (
+	defaultStateDir      = "scheduler_state"
+	defaultMaxConcurrent = 1
+)
+
+// 일정이 있는 소스 하나
+type scheduledJob struct {
+	name     string // 소스 이름 (config.Source.Label)
+	source   config.Source
+	schedule Schedule
+}
+
+// 설정에서 꺼져 있지 않고 schedule이 있는 소스를 골라 일정 해석
+func scheduledJobs(cfg *config.Config) ([]scheduledJob, error) {
+	sources, err := cfg.Selected(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []scheduledJob
+	for _, src := range sources {
+		if src.Schedule == "" {
+			continue
+		}
+		schedule, err := ParseSchedule(src.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("소스 %s: %v", src.Label(), err)
+		}
+		jobs = append(jobs, scheduledJob{name: src.Label(), source: src, schedule: schedule})
+	}
+	if len(jobs) == 0 {
+		return nil, fmt.Errorf("일정(schedule)이 있는 소스가 없습니다")
+	}
+	return jobs, nil
+}
+
+// StateDir 중복 인덱스와 실행 기록 디렉토리
+func StateDir(cfg *config.Config) string {
+	if cfg.Scheduler.StateDir == "" {
+		return defaultStateDir
+	}
+	return cfg.Scheduler.StateDir
+}
+
+func maxConcurrent(cfg *config.Config) int {
+	if cfg.Scheduler.MaxConcurrent <= 0 {
+		return defaultMaxConcurrent
+	}
+	return cfg.Scheduler.MaxConcurrent
+}
+
+// 파일 이름에 쓰지 않는 문자 (소스 이름의 ":", "/" 등)
+var unsafeFileChars = regexp.MustCompile(`[^\p{L}\p{N}_-]`)
+
+// DedupIndexPath 소스의 중복 인덱스 파일 (소스 이름의 글자, 숫자, -, _ 외 문자는 _로 바꿈)
+func DedupIndexPath(stateDir, source string) string {
+	return filepath.Join(stateDir, unsafeFileChars.ReplaceAllString(source, "_")+".dedup.json")
+}
@@ -0,0 +1,85 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduleNext(t *testing.T) {
+	kst := time.FixedZone("KST", 9*60*60)
+	// 2024-04-25 목요일 10:17:30
+	base := time.Date(2024, 4, 25, 10, 17, 30, 0, kst)
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"* * * * *", "2024-04-25 10:18"},
+		{"*/15 * * * *", "2024-04-25 10:30"},
+		{"0 */6 * * *", "2024-04-25 12:00"},
+		{"30 9 * * *", "2024-04-26 09:30"},
+		{"30 9-18/3 * * *", "2024-04-25 12:30"},
+		{"0,45 10 * * *", "2024-04-25 10:45"},
+		{"5/20 * * * *", "2024-04-25 10:25"},
+		{"0 9 * * mon-fri", "2024-04-26 09:00"},
+		{"0 9 * * sat,sun", "2024-04-27 09:00"},
+		{"0 9 * * 7", "2024-04-28 09:00"},
+		{"0 0 1 * *", "2024-05-01 00:00"},
+		{"0 0 31 * *", "2024-05-31 00:00"},
+		{"0 0 29 feb *", "2028-02-29 00:00"},
+		{"0 0 1 * mon", "2024-04-29 00:00"},    // 일과 요일이 모두 지정되면 둘 중 하나
+		{"0 0 */10 * mon", "2024-07-01 00:00"}, // *로 시작하면 둘 다 맞아야 함 (1, 11, 21, 31일 중 월요일)
+		{"@hourly", "2024-04-25 11:00"},
+		{"@daily", "2024-04-26 00:00"},
+		{"@weekly", "2024-04-28 00:00"},
+		{"@monthly", "2024-05-01 00:00"},
+		{"@yearly", "2025-01-01 00:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			s, err := ParseSchedule(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseSchedule(%q): %v", tt.expr, err)
+			}
+			if got := s.Next(base).Format("2006-01-02 15:04"); got != tt.want {
+				t.Errorf("Next = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleNextOnMatchingMinute(t *testing.T) {
+	s, _ := ParseSchedule("0 * * * *")
+	at := time.Date(2024, 4, 25, 10, 0, 0, 0, time.UTC)
+	if got := s.Next(at); !got.Equal(at.Add(time.Hour)) {
+		t.Errorf("Next(%s) = %s, want an hour later", at, got)
+	}
+}
+
+func TestScheduleNextNever(t *testing.T) {
+	s, _ := ParseSchedule("0 0 31 feb *")
+	if got := s.Next(time.Now()); !got.IsZero() {
+		t.Errorf("Next = %s, want zero time", got)
+	}
+}
+
+func TestParseScheduleErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@reboot",
+	} {
+		if _, err := ParseSchedule(expr); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want error", expr)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// 실행 결과
+const (
+	RunOK      = "ok"
+	RunFailed  = "failed"
+	RunSkipped = "skipped" // 이전 실행이 아직 진행 중이라 건너뜀
+)
+
+// 실행 계기
+const (
+	TriggerSchedule = "schedule"
+	TriggerManual   = "manual"
+)
+
+// Run 작업 실행 기록 하나
+type Run struct {
+	Job        string    `json:"job"`
+	Trigger    string    `json:"trigger"`
+	Status     string    `json:"status"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Posts      int       `json:"posts"` // 새로 수집한 게시글 수
+	Error      string    `json:"error,omitempty"`
+}
+
+// Duration 실행 시간
+func (r Run) Duration() time.Duration {
+	return r.FinishedAt.Sub(r.StartedAt)
+}
+
+// History 실행 기록 (JSONL 파일에 한 줄씩 추가)
+type History struct {
+	path string
+	mu   sync.Mutex
+}
+
+// OpenHistory 실행 기록 파일 (없으면 첫 기록 때 생성)
+func OpenHistory(path string) *History {
+	return &History{path: path}
+}
+
+// Append 실행 기록 추가
+func (h *History) Append(run Run) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	line, err := json.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("JSON 변환 실패: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(h.path), 0755); err != nil {
+		return fmt.Errorf("디렉토리 생성 실패: %v", err)
+	}
+	f, err := os.OpenFile(h.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("실행 기록 열기 실패: %v", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("실행 기록 저장 실패: %v", err)
+	}
+	return nil
+}
+
+// Recent 최근 실행 기록 (오래된 것부터, job이 비어있으면 모든 작업, limit이 0이면 전부)
+func (h *History) Recent(job string, limit int) ([]Run, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	f, err := os.Open(h.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("실행 기록 열기 실패: %v", err)
+	}
+	defer f.Close()
+
+	var runs []Run
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var run Run
+		if err := json.Unmarshal(scanner.Bytes(), &run); err != nil {
+			continue // 중간에 끊긴 줄은 무시
+		}
+		if job != "" && run.Job != job {
+			continue
+		}
+		runs = append(runs, run)
+		if limit > 0 && len(runs) > limit {
+			runs = runs[1:]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("실행 기록 읽기 실패: %v", err)
+	}
+	return runs, nil
+}
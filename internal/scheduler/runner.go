@@ -0,0 +1,56 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"naverCrawler/internal/config"
+	"naverCrawler/internal/crawling"
+	"naverCrawler/internal/dedup"
+)
+
+// NaverRunner crawling 패키지로 실제 네이버에서 가져오는 Runner
+// 결과 파일은 설정의 output.blog_dir, output.cafe_dir에 저장됨
+type NaverRunner struct {
+	Config  *config.Config
+	Session *crawling.Session // 카페/검색 소스에 쓸 로그인 세션 (nil이면 카페/검색 소스 실패)
+}
+
+// Run 소스 하나 실행 (크롤링 도중에는 멈출 수 없어 ctx는 시작 전에만 확인)
+func (r NaverRunner) Run(ctx context.Context, src config.Source) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	if src.NeedsSession() && r.Session == nil {
+		return 0, fmt.Errorf("로그인 세션이 없습니다 (credentials.cookie_file 또는 NAVER_COOKIE_FILE/NAVER_COOKIE 필요)")
+	}
+
+	st := config.Stages{
+		Redact: r.Config.Redactor(),
+		Text:   r.Config.Output.TextOptions(),
+		Chunks: r.Config.Output.ChunkOptions(),
+	}
+	if r.Config.Scheduler.IsIncremental() {
+		var err error
+		if st.Dedup, err = dedup.Load(DedupIndexPath(StateDir(r.Config), src.Label())); err != nil {
+			return 0, err
+		}
+	}
+
+	posts, err := r.Config.Crawl(src, r.Session, st)
+	if err != nil {
+		return 0, err
+	}
+
+	// 실패한 실행은 인덱스를 저장하지 않아 다음 실행에서 다시 수집
+	if st.Dedup != nil {
+		if err := st.Dedup.Save(); err != nil {
+			return posts, err
+		}
+	}
+	if st.Redact != nil {
+		log.Printf("🔒 %s 가린 개인정보: %s", src.Label(), st.Redact.Summary())
+	}
+	return posts, nil
+}
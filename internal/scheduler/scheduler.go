@@ -0,0 +1,223 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"naverCrawler/internal/config"
+)
+
+// Runner 소스 하나를 실행하고 새로 수집한 게시글 수 반환 (테스트에서는 가짜 구현으로 교체)
+type Runner interface {
+	Run(ctx context.Context, src config.Source) (int, error)
+}
+
+// Scheduler 작업을 일정에 맞춰 실행
+type Scheduler struct {
+	cfg     *config.Config
+	jobs    []scheduledJob
+	runner  Runner
+	history *History
+	now     func() time.Time
+
+	slots chan struct{} // 동시 실행 제한
+
+	mu      sync.Mutex
+	running map[string]bool
+	next    map[string]time.Time
+	wg      sync.WaitGroup
+}
+
+// New 스케줄러 생성 (cfg는 config.Load로 읽고 Validate로 확인한 설정)
+func New(cfg *config.Config, runner Runner) (*Scheduler, error) {
+	jobs, err := scheduledJobs(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Scheduler{
+		cfg:     cfg,
+		jobs:    jobs,
+		runner:  runner,
+		history: OpenHistory(filepath.Join(StateDir(cfg), "history.jsonl")),
+		now:     time.Now,
+		slots:   make(chan struct{}, maxConcurrent(cfg)),
+		running: make(map[string]bool),
+		next:    make(map[string]time.Time),
+	}, nil
+}
+
+// History 실행 기록
+func (s *Scheduler) History() *History {
+	return s.history
+}
+
+// Jobs 일정이 있는 소스 이름
+func (s *Scheduler) Jobs() []string {
+	names := make([]string, len(s.jobs))
+	for i, job := range s.jobs {
+		names[i] = job.name
+	}
+	return names
+}
+
+// 이름으로 소스 찾기 (수동 실행은 일정이 없거나 꺼진 소스도 가능)
+func (s *Scheduler) job(name string) (scheduledJob, bool) {
+	for _, src := range s.cfg.Sources {
+		if src.Label() == name {
+			return scheduledJob{name: name, source: src}, true
+		}
+	}
+	return scheduledJob{}, false
+}
+
+// Start ctx가 끝날 때까지 일정에 맞춰 작업 실행 (끝나면 실행 중인 작업을 기다린 뒤 반환)
+// 데몬이 꺼져 있는 동안 놓친 실행은 따라잡지 않음
+func (s *Scheduler) Start(ctx context.Context) {
+	now := s.now()
+	s.mu.Lock()
+	for _, job := range s.jobs {
+		s.next[job.name] = job.schedule.Next(now)
+		log.Printf("⏰ %s 다음 실행: %s", job.name, s.next[job.name].Format("2006-01-02 15:04"))
+	}
+	s.mu.Unlock()
+
+	for {
+		wake, ok := s.nextWake()
+		if !ok {
+			log.Printf("⚠️ 실행할 작업이 없습니다")
+			<-ctx.Done()
+			s.wg.Wait()
+			return
+		}
+		timer := time.NewTimer(time.Until(wake))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.wg.Wait()
+			return
+		case <-timer.C:
+			s.dispatch(ctx, s.now())
+		}
+	}
+}
+
+// 가장 가까운 다음 실행 시각
+func (s *Scheduler) nextWake() (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var wake time.Time
+	for _, t := range s.next {
+		if !t.IsZero() && (wake.IsZero() || t.Before(wake)) {
+			wake = t
+		}
+	}
+	return wake, !wake.IsZero()
+}
+
+// 실행 시각이 된 작업을 시작하고 다음 실행 시각 계산
+func (s *Scheduler) dispatch(ctx context.Context, now time.Time) {
+	var due []scheduledJob
+	s.mu.Lock()
+	for _, job := range s.jobs {
+		t, ok := s.next[job.name]
+		if !ok || t.IsZero() || t.After(now) {
+			continue
+		}
+		s.next[job.name] = job.schedule.Next(now)
+		due = append(due, job)
+	}
+	s.mu.Unlock()
+
+	for _, job := range due {
+		if !s.acquire(job, TriggerSchedule) {
+			continue
+		}
+		s.wg.Add(1)
+		go func(job scheduledJob) {
+			defer s.wg.Done()
+			s.execute(ctx, job, TriggerSchedule)
+		}(job)
+	}
+}
+
+// RunNow 작업을 바로 실행하고 끝날 때까지 기다림
+func (s *Scheduler) RunNow(ctx context.Context, name string) (Run, error) {
+	job, ok := s.job(name)
+	if !ok {
+		return Run{}, fmt.Errorf("설정에 없는 소스: %s", name)
+	}
+	if !s.acquire(job, TriggerManual) {
+		return Run{}, fmt.Errorf("작업 %s의 이전 실행이 아직 진행 중입니다", name)
+	}
+	return s.execute(ctx, job, TriggerManual), nil
+}
+
+// 같은 작업이 실행 중이 아니면 실행 중으로 표시 (실행 중이면 건너뛴 기록을 남기고 false)
+func (s *Scheduler) acquire(job scheduledJob, trigger string) bool {
+	s.mu.Lock()
+	busy := s.running[job.name]
+	if !busy {
+		s.running[job.name] = true
+	}
+	s.mu.Unlock()
+
+	if busy {
+		log.Printf("⏭️ %s: 이전 실행이 아직 진행 중이라 건너뜁니다", job.name)
+		now := s.now()
+		s.record(Run{
+			Job:        job.name,
+			Trigger:    trigger,
+			Status:     RunSkipped,
+			StartedAt:  now,
+			FinishedAt: now,
+			Error:      "이전 실행이 아직 진행 중",
+		})
+	}
+	return !busy
+}
+
+// 동시 실행 슬롯을 기다렸다가 작업 실행 (acquire 후 호출)
+func (s *Scheduler) execute(ctx context.Context, job scheduledJob, trigger string) Run {
+	defer func() {
+		s.mu.Lock()
+		delete(s.running, job.name)
+		s.mu.Unlock()
+	}()
+
+	run := Run{Job: job.name, Trigger: trigger}
+	select {
+	case s.slots <- struct{}{}:
+		defer func() { <-s.slots }()
+	case <-ctx.Done():
+		// 종료 중에는 기다리던 작업을 시작하지 않음
+		run.Status, run.Error = RunSkipped, "스케줄러 종료"
+		run.StartedAt, run.FinishedAt = s.now(), s.now()
+		s.record(run)
+		return run
+	}
+
+	log.Printf("🚀 %s 실행 시작 (%s)", job.name, job.source.Type)
+	run.StartedAt = s.now()
+	posts, err := s.runner.Run(ctx, job.source)
+	run.FinishedAt = s.now()
+	run.Posts = posts
+	run.Status = RunOK
+	if err != nil {
+		run.Status, run.Error = RunFailed, err.Error()
+		log.Printf("❌ %s 실행 실패 (%s): %v", job.name, run.Duration().Round(time.Second), err)
+	} else {
+		log.Printf("✅ %s 실행 완료 (%s): 새 게시글 %d개", job.name, run.Duration().Round(time.Second), posts)
+	}
+	s.record(run)
+	return run
+}
+
+func (s *Scheduler) record(run Run) {
+	if err := s.history.Append(run); err != nil {
+		log.Printf("⚠️ 실행 기록 저장 실패: %v", err)
+	}
+}
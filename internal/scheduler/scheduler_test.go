@@ -0,0 +1,229 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"naverCrawler/internal/config"
+)
+
+// 작업 이름별로 결과를 돌려주는 가짜 Runner
+// block이 있으면 값을 받을 때까지 실행 중으로 머묾
+type fakeRunner struct {
+	mu      sync.Mutex
+	calls   []string
+	started chan string
+	block   chan struct{}
+}
+
+func (f *fakeRunner) Run(ctx context.Context, src config.Source) (int, error) {
+	f.mu.Lock()
+	f.calls = append(f.calls, src.Label())
+	f.mu.Unlock()
+	if f.started != nil {
+		f.started <- src.Label()
+	}
+	if f.block != nil {
+		<-f.block
+	}
+	if src.Label() == "broken" {
+		return 0, fmt.Errorf("목록 요청 실패")
+	}
+	return 3, nil
+}
+
+func testConfig(t *testing.T, sources ...config.Source) *config.Config {
+	return &config.Config{Scheduler: config.Scheduler{StateDir: t.TempDir()}, Sources: sources}
+}
+
+func blogSource(name, schedule string) config.Source {
+	return config.Source{Name: name, Type: config.SourceBlog, BlogID: name, Schedule: schedule}
+}
+
+func TestScheduledJobs(t *testing.T) {
+	off := blogSource("off", "@hourly")
+	off.Disabled = true
+	cfg := testConfig(t,
+		blogSource("minwon-blog", "0 */6 * * *"),
+		config.Source{Type: config.SourceSearch, CafeID: "12345", Query: "배터리", Schedule: "@daily"},
+		blogSource("manual-only", ""),
+		off,
+	)
+	s, err := New(cfg, &fakeRunner{})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if got := strings.Join(s.Jobs(), ","); got != "minwon-blog,search:12345/배터리" {
+		t.Errorf("Jobs = %s", got)
+	}
+	if maxConcurrent(cfg) != 1 || StateDir(&config.Config{}) != "scheduler_state" || !cfg.Scheduler.IsIncremental() {
+		t.Errorf("defaults: max_concurrent %d, state_dir %q", maxConcurrent(cfg), StateDir(&config.Config{}))
+	}
+
+	// 일정이 없거나 꺼진 소스도 수동 실행은 가능
+	if run, err := s.RunNow(context.Background(), "manual-only"); err != nil || run.Status != RunOK {
+		t.Errorf("RunNow(manual-only) = %+v, %v", run, err)
+	}
+
+	if got := DedupIndexPath("state", "search:12345/배터리"); got != filepath.Join("state", "search_12345_배터리.dedup.json") {
+		t.Errorf("DedupIndexPath = %s", got)
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		sources []config.Source
+		want    string
+	}{
+		{"no scheduled sources", []config.Source{blogSource("a", "")}, "schedule"},
+		{"bad schedule", []config.Source{blogSource("a", "every day")}, "소스 a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(testConfig(t, tt.sources...), &fakeRunner{})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("New error = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	runner := &fakeRunner{}
+	off := blogSource("off", "@hourly")
+	off.Disabled = true
+	cfg := testConfig(t, blogSource("hourly", "@hourly"), blogSource("daily", "@daily"), off)
+	s, err := New(cfg, runner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2024, 4, 25, 10, 17, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.Start(ctx) // 다음 실행 시각만 계산하고 바로 종료
+
+	if wake, _ := s.nextWake(); !wake.Equal(time.Date(2024, 4, 25, 11, 0, 0, 0, time.UTC)) {
+		t.Errorf("nextWake = %s", wake)
+	}
+
+	ctx = context.Background()
+	now = time.Date(2024, 4, 25, 11, 0, 0, 0, time.UTC)
+	s.dispatch(ctx, now)
+	s.wg.Wait()
+	now = time.Date(2024, 4, 26, 0, 0, 0, 0, time.UTC)
+	s.dispatch(ctx, now)
+	s.wg.Wait()
+
+	// 11시에는 hourly만, 자정에는 둘 다 실행 (disabled는 실행 안 함)
+	runner.mu.Lock()
+	calls := strings.Join(runner.calls, ",")
+	runner.mu.Unlock()
+	if calls != "hourly,daily,hourly" && calls != "hourly,hourly,daily" {
+		t.Errorf("calls = %s", calls)
+	}
+
+	runs, err := s.History().Recent("", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 3 {
+		t.Fatalf("history = %+v", runs)
+	}
+	for _, run := range runs {
+		if run.Status != RunOK || run.Posts != 3 || run.Trigger != TriggerSchedule {
+			t.Errorf("run = %+v", run)
+		}
+	}
+}
+
+func TestOverlappingRunIsSkipped(t *testing.T) {
+	runner := &fakeRunner{started: make(chan string, 1), block: make(chan struct{})}
+	s, err := New(testConfig(t, blogSource("slow", "* * * * *")), runner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2024, 4, 25, 10, 0, 0, 0, time.UTC)
+	s.next["slow"] = now
+
+	ctx := context.Background()
+	s.dispatch(ctx, now)
+	<-runner.started
+
+	// 이전 실행이 끝나지 않았으므로 다음 일정과 수동 실행 모두 건너뜀
+	s.dispatch(ctx, now.Add(time.Minute))
+	if _, err := s.RunNow(ctx, "slow"); err == nil {
+		t.Error("RunNow during a running job succeeded")
+	}
+
+	close(runner.block)
+	s.wg.Wait()
+
+	runs, _ := s.History().Recent("slow", 0)
+	var statuses []string
+	for _, run := range runs {
+		statuses = append(statuses, run.Trigger+":"+run.Status)
+	}
+	if got := strings.Join(statuses, ","); got != "schedule:skipped,manual:skipped,schedule:ok" {
+		t.Errorf("history = %s", got)
+	}
+
+	// 끝난 뒤에는 다시 실행 가능
+	runner.started = nil
+	run, err := s.RunNow(ctx, "slow")
+	if err != nil || run.Status != RunOK || run.Trigger != TriggerManual {
+		t.Errorf("RunNow = %+v, %v", run, err)
+	}
+}
+
+func TestRunNowFailure(t *testing.T) {
+	s, err := New(testConfig(t, blogSource("broken", "@daily")), &fakeRunner{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	run, err := s.RunNow(context.Background(), "broken")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.Status != RunFailed || run.Error != "목록 요청 실패" {
+		t.Errorf("run = %+v", run)
+	}
+	if _, err := s.RunNow(context.Background(), "missing"); err == nil {
+		t.Error("RunNow for unknown job succeeded")
+	}
+}
+
+func TestHistoryRecent(t *testing.T) {
+	h := OpenHistory(filepath.Join(t.TempDir(), "state", "history.jsonl"))
+	if runs, err := h.Recent("", 0); err != nil || len(runs) != 0 {
+		t.Fatalf("empty history = %v, %v", runs, err)
+	}
+	for i := 1; i <= 5; i++ {
+		job := "a"
+		if i%2 == 0 {
+			job = "b"
+		}
+		if err := h.Append(Run{Job: job, Status: RunOK, Posts: i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	runs, err := h.Recent("a", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 2 || runs[0].Posts != 3 || runs[1].Posts != 5 {
+		t.Errorf("Recent(a, 2) = %+v", runs)
+	}
+	if runs, _ := h.Recent("", 0); len(runs) != 5 {
+		t.Errorf("Recent all = %d runs, want 5", len(runs))
+	}
+}
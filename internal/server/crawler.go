@@ -0,0 +1,30 @@
+package server
+
+import "naverCrawler/internal/crawling"
+
+// Crawler 서버가 사용하는 크롤링 함수 (테스트에서는 가짜 구현으로 교체)
+type Crawler interface {
+	CrawlBlog(blogID string, opts crawling.BlogCrawlOptions) ([]crawling.BlogPost, error)
+	CrawlBoard(cafeId, boardID string, session *crawling.Session, opts crawling.CafeCrawlOptions) ([]map[string]interface{}, error)
+	BlogPost(blogID, logNo string) (crawling.BlogPost, error)
+	CafeArticle(cafeId string, articleId int, session *crawling.Session) (map[string]interface{}, error)
+}
+
+// NaverCrawler crawling 패키지로 실제 네이버에서 가져오는 Crawler
+type NaverCrawler struct{}
+
+func (NaverCrawler) CrawlBlog(blogID string, opts crawling.BlogCrawlOptions) ([]crawling.BlogPost, error) {
+	return crawling.CrawlBlog(blogID, opts)
+}
+
+func (NaverCrawler) CrawlBoard(cafeId, boardID string, session *crawling.Session, opts crawling.CafeCrawlOptions) ([]map[string]interface{}, error) {
+	return crawling.CrawlBoard(cafeId, boardID, session, opts)
+}
+
+func (NaverCrawler) BlogPost(blogID, logNo string) (crawling.BlogPost, error) {
+	return crawling.GetBlogPostDetail(blogID, logNo)
+}
+
+func (NaverCrawler) CafeArticle(cafeId string, articleId int, session *crawling.Session) (map[string]interface{}, error) {
+	return crawling.GetArticleDetail(cafeId, articleId, session)
+}
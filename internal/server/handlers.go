@@ -0,0 +1,242 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"naverCrawler/internal/crawling"
+)
+
+// Handler API 라우팅
+//
+//	POST   /jobs                          작업 등록 (JobRequest)
+//	GET    /jobs                          작업 목록
+//	GET    /jobs/{id}                     작업 상태/진행 상황
+//	DELETE /jobs/{id}                     대기 중인 작업 취소
+//	GET    /jobs/{id}/results             결과 NDJSON (?from=N부터, ?follow=0이면 지금까지의 결과만)
+//	GET    /blogs/{blogID}/posts/{logNo}  블로그 게시글 즉시 조회
+//	GET    /cafes/{cafeID}/articles/{id}  카페 게시글 즉시 조회
+//	GET    /posts?url=                    게시글 URL로 즉시 조회
+//	GET    /healthz                       상태 확인 (토큰 없이 호출 가능)
+//
+// /healthz 외에는 "Authorization: Bearer {Config.Token}" 헤더가 있어야 함
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /jobs", s.handleSubmit)
+	mux.HandleFunc("GET /jobs", s.handleList)
+	mux.HandleFunc("GET /jobs/{id}", s.handleStatus)
+	mux.HandleFunc("DELETE /jobs/{id}", s.handleCancel)
+	mux.HandleFunc("GET /jobs/{id}/results", s.handleResults)
+	mux.HandleFunc("GET /blogs/{blogID}/posts/{logNo}", s.handleBlogPost)
+	mux.HandleFunc("GET /cafes/{cafeID}/articles/{articleID}", s.handleCafeArticle)
+	mux.HandleFunc("GET /posts", s.handlePostByURL)
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"status": "ok", "queued": len(s.queue)})
+	})
+	return s.requireToken(mux)
+}
+
+// API 토큰 확인 (/healthz 제외)
+func (s *Server) requireToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || s.cfg.Token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.cfg.Token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, errors.New("API 토큰이 없거나 올바르지 않습니다"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+func (s *Server) handleSubmit(w http.ResponseWriter, r *http.Request) {
+	var req JobRequest
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("요청 JSON 형식 오류: "+err.Error()))
+		return
+	}
+
+	job, err := s.Submit(req)
+	switch {
+	case errors.Is(err, ErrQueueFull):
+		w.Header().Set("Retry-After", "30")
+		writeError(w, http.StatusServiceUnavailable, err)
+	case err != nil:
+		writeError(w, http.StatusBadRequest, err)
+	default:
+		w.Header().Set("Location", "/jobs/"+job.ID)
+		writeJSON(w, http.StatusAccepted, job.view())
+	}
+}
+
+func (s *Server) handleList(w http.ResponseWriter, r *http.Request) {
+	views := []JobView{}
+	for _, job := range s.Jobs() {
+		views = append(views, job.view())
+	}
+	writeJSON(w, http.StatusOK, views)
+}
+
+func (s *Server) jobFromPath(w http.ResponseWriter, r *http.Request) (*Job, bool) {
+	job, ok := s.Job(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, errors.New("작업을 찾을 수 없습니다: "+r.PathValue("id")))
+	}
+	return job, ok
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	if job, ok := s.jobFromPath(w, r); ok {
+		writeJSON(w, http.StatusOK, job.view())
+	}
+}
+
+func (s *Server) handleCancel(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.jobFromPath(w, r)
+	if !ok {
+		return
+	}
+	if !job.cancel() {
+		writeError(w, http.StatusConflict, errors.New("대기 중인 작업만 취소할 수 있습니다 (현재 "+string(job.view().Status)+")"))
+		return
+	}
+	writeJSON(w, http.StatusOK, job.view())
+}
+
+// 결과를 NDJSON으로 보내고, 작업이 끝나지 않았으면 새 결과가 나올 때마다 이어서 보냄
+func (s *Server) handleResults(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.jobFromPath(w, r)
+	if !ok {
+		return
+	}
+	from := 0
+	if v := r.URL.Query().Get("from"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			writeError(w, http.StatusBadRequest, errors.New("from은 0 이상의 숫자여야 합니다"))
+			return
+		}
+		from = n
+	}
+	follow := r.URL.Query().Get("follow") != "0"
+
+	w.Header().Set("Content-Type", "application/x-ndjson; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+
+	for {
+		results, finished, changed := job.resultsFrom(from)
+		for _, result := range results {
+			if err := enc.Encode(result); err != nil {
+				return
+			}
+		}
+		from += len(results)
+		if flusher != nil {
+			flusher.Flush()
+		}
+		if finished || !follow {
+			return
+		}
+
+		select {
+		case <-changed:
+		case <-r.Context().Done():
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// 즉시 조회 동시 요청 제한 (슬롯이 빌 때까지 기다리고, 요청이 끊기면 false)
+func (s *Server) acquire(r *http.Request) bool {
+	select {
+	case s.direct <- struct{}{}:
+		return true
+	case <-r.Context().Done():
+		return false
+	}
+}
+
+func (s *Server) release() {
+	<-s.direct
+}
+
+func (s *Server) serveFetch(w http.ResponseWriter, r *http.Request, ref crawling.PostRef) {
+	if ref.Source == crawling.SourceCafe && s.cfg.Session == nil {
+		writeError(w, http.StatusServiceUnavailable, errors.New("서버에 네이버 로그인 세션이 설정되지 않았습니다"))
+		return
+	}
+	if !s.acquire(r) {
+		return
+	}
+	defer s.release()
+
+	post, err := s.fetch(ref)
+	switch {
+	case errors.Is(err, crawling.ErrBlogPostInaccessible):
+		// 비공개/삭제 등은 상태가 담긴 게시글로 응답
+		writeJSON(w, http.StatusOK, post)
+	case errors.Is(err, crawling.ErrSessionExpired):
+		writeError(w, http.StatusServiceUnavailable, err)
+	case err != nil:
+		writeError(w, http.StatusBadGateway, err)
+	default:
+		writeJSON(w, http.StatusOK, post)
+	}
+}
+
+func (s *Server) handleBlogPost(w http.ResponseWriter, r *http.Request) {
+	s.serveFetch(w, r, crawling.PostRef{
+		Source: crawling.SourceBlog,
+		BlogID: r.PathValue("blogID"),
+		LogNo:  r.PathValue("logNo"),
+	})
+}
+
+func (s *Server) handleCafeArticle(w http.ResponseWriter, r *http.Request) {
+	articleID, err := strconv.Atoi(r.PathValue("articleID"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("게시글 ID는 숫자여야 합니다"))
+		return
+	}
+	s.serveFetch(w, r, crawling.PostRef{
+		Source:    crawling.SourceCafe,
+		CafeID:    r.PathValue("cafeID"),
+		ArticleID: articleID,
+	})
+}
+
+func (s *Server) handlePostByURL(w http.ResponseWriter, r *http.Request) {
+	ref, err := crawling.ParsePostURL(r.URL.Query().Get("url"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	s.serveFetch(w, r, ref)
+}
@@ -0,0 +1,249 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"naverCrawler/internal/crawling"
+)
+
+// 작업 종류
+const (
+	JobBlog = "blog" // 블로그 전체 크롤링 (CrawlBlog)
+	JobCafe = "cafe" // 카페 게시판 크롤링 (CrawlBoard)
+	JobURLs = "urls" // 게시글 URL 목록의 상세 정보
+)
+
+// JobStatus 작업 상태
+type JobStatus string
+
+const (
+	StatusQueued   JobStatus = "queued"
+	StatusRunning  JobStatus = "running"
+	StatusDone     JobStatus = "done"
+	StatusFailed   JobStatus = "failed"
+	StatusCanceled JobStatus = "canceled"
+)
+
+func (s JobStatus) finished() bool {
+	return s == StatusDone || s == StatusFailed || s == StatusCanceled
+}
+
+// JobRequest 작업 요청 (POST /jobs 본문)
+type JobRequest struct {
+	Type string `json:"type"` // blog, cafe, urls
+
+	// blog
+	BlogID  string `json:"blog_id,omitempty"`
+	Backend string `json:"backend,omitempty"` // desktop, mobile
+
+	// cafe
+	CafeID    string `json:"cafe_id,omitempty"`
+	BoardID   string `json:"board_id,omitempty"`
+	StartPage int    `json:"start_page,omitempty"`
+	EndPage   int    `json:"end_page,omitempty"`
+
+	// blog, cafe 공통
+	MaxPages int `json:"max_pages,omitempty"`
+	PageSize int `json:"page_size,omitempty"`
+
+	// urls
+	URLs []string `json:"urls,omitempty"`
+}
+
+// 요청 확인 (URL 목록은 미리 해석해 둠)
+func (r JobRequest) validate(hasSession bool) ([]crawling.PostRef, error) {
+	switch r.Type {
+	case JobBlog:
+		if r.BlogID == "" {
+			return nil, fmt.Errorf("blog_id가 필요합니다")
+		}
+		if _, err := crawling.BlogBackendByName(r.backendName()); err != nil {
+			return nil, err
+		}
+	case JobCafe:
+		if r.CafeID == "" || r.BoardID == "" {
+			return nil, fmt.Errorf("cafe_id와 board_id가 필요합니다")
+		}
+		if !hasSession {
+			return nil, fmt.Errorf("서버에 네이버 로그인 세션이 설정되지 않아 카페 작업을 실행할 수 없습니다")
+		}
+	case JobURLs:
+		if len(r.URLs) == 0 {
+			return nil, fmt.Errorf("urls가 비어있습니다")
+		}
+		refs := make([]crawling.PostRef, len(r.URLs))
+		var invalid []string
+		for i, u := range r.URLs {
+			ref, err := crawling.ParsePostURL(u)
+			if err != nil {
+				invalid = append(invalid, u)
+				continue
+			}
+			if ref.Source == crawling.SourceCafe && !hasSession {
+				return nil, fmt.Errorf("서버에 네이버 로그인 세션이 설정되지 않아 카페 게시글을 가져올 수 없습니다: %s", u)
+			}
+			refs[i] = ref
+		}
+		if len(invalid) > 0 {
+			return nil, fmt.Errorf("지원하지 않는 URL: %s", strings.Join(invalid, ", "))
+		}
+		return refs, nil
+	default:
+		return nil, fmt.Errorf("알 수 없는 작업 종류: %q (blog, cafe, urls 중 하나)", r.Type)
+	}
+	return nil, nil
+}
+
+func (r JobRequest) backendName() string {
+	if r.Backend == "" {
+		return "desktop"
+	}
+	return r.Backend
+}
+
+// Progress 작업 진행 상황
+type Progress struct {
+	Done  int `json:"done"`  // 처리한 페이지 수 (urls 작업은 URL 수)
+	Total int `json:"total"` // 전체 페이지 수 (모르면 0)
+	Posts int `json:"posts"` // 지금까지 수집한 게시글 수
+}
+
+// Job 큐에 들어간 크롤링 작업
+type Job struct {
+	ID      string
+	Request JobRequest
+
+	refs []crawling.PostRef // urls 작업의 해석된 URL
+
+	mu         sync.Mutex
+	status     JobStatus
+	progress   Progress
+	err        string
+	createdAt  time.Time
+	startedAt  time.Time
+	finishedAt time.Time
+	results    []interface{}
+	changed    chan struct{} // 결과/상태가 바뀔 때마다 닫고 새로 만듦
+}
+
+func newJob(id string, req JobRequest, refs []crawling.PostRef) *Job {
+	return &Job{
+		ID:        id,
+		Request:   req,
+		refs:      refs,
+		status:    StatusQueued,
+		createdAt: time.Now(),
+		changed:   make(chan struct{}),
+	}
+}
+
+// 기다리던 결과 스트림에 변경 알림 (mu를 잡은 상태에서 호출)
+func (j *Job) notify() {
+	close(j.changed)
+	j.changed = make(chan struct{})
+}
+
+// 대기 중이면 실행 상태로 (이미 취소되었으면 false)
+func (j *Job) start() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.status != StatusQueued {
+		return false
+	}
+	j.status = StatusRunning
+	j.startedAt = time.Now()
+	j.notify()
+	return true
+}
+
+// 대기 중인 작업 취소 (실행 중인 크롤링은 중간에 멈출 수 없으므로 false)
+func (j *Job) cancel() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.status != StatusQueued {
+		return false
+	}
+	j.status = StatusCanceled
+	j.finishedAt = time.Now()
+	j.notify()
+	return true
+}
+
+// 처리한 단위(페이지/URL)와 결과 추가
+func (j *Job) addResults(done, total int, results []interface{}) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.progress.Done += done
+	if total > 0 {
+		j.progress.Total = total
+	}
+	j.progress.Posts += len(results)
+	j.results = append(j.results, results...)
+	j.notify()
+}
+
+func (j *Job) finish(err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.status = StatusDone
+	if err != nil {
+		j.status = StatusFailed
+		j.err = err.Error()
+	}
+	j.finishedAt = time.Now()
+	j.notify()
+}
+
+// from번째부터의 결과, 작업 종료 여부, 다음 변경 알림 채널
+func (j *Job) resultsFrom(from int) ([]interface{}, bool, <-chan struct{}) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	var results []interface{}
+	if from < len(j.results) {
+		results = append(results, j.results[from:]...)
+	}
+	return results, j.status.finished(), j.changed
+}
+
+func (j *Job) isFinished() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.status.finished()
+}
+
+// JobView 작업 상태 응답
+type JobView struct {
+	ID         string     `json:"id"`
+	Request    JobRequest `json:"request"`
+	Status     JobStatus  `json:"status"`
+	Progress   Progress   `json:"progress"`
+	Error      string     `json:"error,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+func (j *Job) view() JobView {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	v := JobView{
+		ID:        j.ID,
+		Request:   j.Request,
+		Status:    j.status,
+		Progress:  j.progress,
+		Error:     j.err,
+		CreatedAt: j.createdAt,
+	}
+	if !j.startedAt.IsZero() {
+		t := j.startedAt
+		v.StartedAt = &t
+	}
+	if !j.finishedAt.IsZero() {
+		t := j.finishedAt
+		v.FinishedAt = &t
+	}
+	return v
+}
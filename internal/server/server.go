@@ -0,0 +1,268 @@
+// Package server 크롤링 작업을 REST API로 받아 대기열에서 정해진 수만큼 동시에 실행하고,
+// 진행 상황과 결과(NDJSON 스트림), 게시글 하나의 즉시 조회를 제공하는 HTTP 서버
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"naverCrawler/internal/crawling"
+)
+
+// Config 서버 설정
+type Config struct {
+	Workers         int               // 동시에 실행할 작업 수 (기본값: 2)
+	QueueSize       int               // 대기열 크기, 가득 차면 작업 거절 (기본값: 100)
+	MaxDirect       int               // 게시글 즉시 조회 동시 요청 수 (기본값: 4)
+	MaxFinishedJobs int               // 메모리에 남겨 둘 끝난 작업 수, 넘으면 오래된 것부터 삭제 (기본값: 100)
+	Session         *crawling.Session // 카페 작업/조회에 쓸 로그인 세션 (nil이면 카페 요청 거절)
+	Crawler         Crawler           // 기본값: NaverCrawler
+
+	// API 토큰: /healthz를 뺀 모든 요청에 "Authorization: Bearer {Token}" 필요 (비어있으면 모든 요청 거절)
+	Token string
+}
+
+func (c Config) withDefaults() Config {
+	if c.Workers <= 0 {
+		c.Workers = 2
+	}
+	if c.QueueSize <= 0 {
+		c.QueueSize = 100
+	}
+	if c.MaxDirect <= 0 {
+		c.MaxDirect = 4
+	}
+	if c.MaxFinishedJobs <= 0 {
+		c.MaxFinishedJobs = 100
+	}
+	if c.Crawler == nil {
+		c.Crawler = NaverCrawler{}
+	}
+	return c
+}
+
+// ErrQueueFull 대기열이 가득 차 작업을 받을 수 없음
+var ErrQueueFull = errors.New("작업 대기열이 가득 찼습니다")
+
+// Server 작업 대기열과 HTTP API
+type Server struct {
+	cfg Config
+
+	queue  chan *Job
+	direct chan struct{} // 즉시 조회 동시 요청 제한
+
+	mu    sync.Mutex
+	jobs  map[string]*Job
+	order []string // 작업 생성 순서
+	seq   int
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New 서버를 만들고 작업자 시작 (Close로 종료)
+func New(cfg Config) *Server {
+	cfg = cfg.withDefaults()
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Server{
+		cfg:    cfg,
+		queue:  make(chan *Job, cfg.QueueSize),
+		direct: make(chan struct{}, cfg.MaxDirect),
+		jobs:   make(map[string]*Job),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	for i := 0; i < cfg.Workers; i++ {
+		s.wg.Add(1)
+		go s.worker()
+	}
+	return s
+}
+
+// Close 새 작업 실행을 멈추고 실행 중인 작업이 끝날 때까지 기다림 (대기 중인 작업은 취소)
+func (s *Server) Close() {
+	s.cancel()
+	s.wg.Wait()
+	for {
+		select {
+		case job := <-s.queue:
+			job.cancel()
+		default:
+			return
+		}
+	}
+}
+
+// Submit 작업을 대기열에 추가
+func (s *Server) Submit(req JobRequest) (*Job, error) {
+	refs, err := req.validate(s.cfg.Session != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.seq++
+	job := newJob(fmt.Sprintf("job-%06d", s.seq), req, refs)
+	select {
+	case s.queue <- job:
+	default:
+		s.mu.Unlock()
+		return nil, ErrQueueFull
+	}
+	s.jobs[job.ID] = job
+	s.order = append(s.order, job.ID)
+	s.mu.Unlock()
+
+	log.Printf("📥 작업 %s 등록 (%s)", job.ID, req.Type)
+	return job, nil
+}
+
+// Job ID로 작업 찾기
+func (s *Server) Job(id string) (*Job, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	return job, ok
+}
+
+// Jobs 생성 순서대로 모든 작업
+func (s *Server) Jobs() []*Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	jobs := make([]*Job, 0, len(s.order))
+	for _, id := range s.order {
+		jobs = append(jobs, s.jobs[id])
+	}
+	return jobs
+}
+
+// 끝난 작업이 MaxFinishedJobs를 넘으면 오래된 것부터 삭제
+func (s *Server) prune() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	finished := 0
+	for _, id := range s.order {
+		if s.jobs[id].isFinished() {
+			finished++
+		}
+	}
+	kept := s.order[:0]
+	for _, id := range s.order {
+		if finished > s.cfg.MaxFinishedJobs && s.jobs[id].isFinished() {
+			delete(s.jobs, id)
+			finished--
+			continue
+		}
+		kept = append(kept, id)
+	}
+	s.order = kept
+}
+
+func (s *Server) worker() {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case job := <-s.queue:
+			if !job.start() {
+				continue // 대기 중 취소된 작업
+			}
+			log.Printf("🚀 작업 %s 시작 (%s)", job.ID, job.Request.Type)
+			err := s.run(job)
+			job.finish(err)
+			if err != nil {
+				log.Printf("❌ 작업 %s 실패: %v", job.ID, err)
+			} else {
+				log.Printf("✅ 작업 %s 완료 (게시글 %d개)", job.ID, job.view().Progress.Posts)
+			}
+			s.prune()
+		}
+	}
+}
+
+// URL 작업의 결과 한 줄
+type urlResult struct {
+	URL   string      `json:"url"`
+	Post  interface{} `json:"post,omitempty"`
+	Error string      `json:"error,omitempty"`
+}
+
+func (s *Server) run(job *Job) error {
+	req := job.Request
+	switch req.Type {
+	case JobBlog:
+		backend, err := crawling.BlogBackendByName(req.backendName())
+		if err != nil {
+			return err
+		}
+		_, err = s.cfg.Crawler.CrawlBlog(req.BlogID, crawling.BlogCrawlOptions{
+			MaxPages: req.MaxPages,
+			PageSize: req.PageSize,
+			Backend:  backend,
+			OnPage: func(page, total int, posts []crawling.BlogPost) {
+				results := make([]interface{}, len(posts))
+				for i, post := range posts {
+					results[i] = post
+				}
+				job.addResults(1, total, results)
+			},
+		})
+		return err
+
+	case JobCafe:
+		pageSize := req.PageSize
+		if pageSize <= 0 {
+			pageSize = 15
+		}
+		_, err := s.cfg.Crawler.CrawlBoard(req.CafeID, req.BoardID, s.cfg.Session, crawling.CafeCrawlOptions{
+			StartPage: req.StartPage,
+			EndPage:   req.EndPage,
+			MaxPages:  req.MaxPages,
+			PageSize:  pageSize,
+			OnPage: func(page, total int, posts []map[string]interface{}) {
+				results := make([]interface{}, len(posts))
+				for i, post := range posts {
+					results[i] = post
+				}
+				job.addResults(1, total, results)
+			},
+		})
+		return err
+
+	case JobURLs:
+		for i, ref := range job.refs {
+			post, err := s.fetch(ref)
+			result := urlResult{URL: req.URLs[i], Post: post}
+			if err != nil {
+				result.Error = err.Error()
+			}
+			job.addResults(1, len(job.refs), []interface{}{result})
+		}
+		return nil
+	}
+	return fmt.Errorf("알 수 없는 작업 종류: %s", req.Type)
+}
+
+// 게시글 하나 가져오기 (접근 불가 블로그 글은 상태가 담긴 게시글과 오류를 함께 반환)
+func (s *Server) fetch(ref crawling.PostRef) (interface{}, error) {
+	switch ref.Source {
+	case crawling.SourceBlog:
+		post, err := s.cfg.Crawler.BlogPost(ref.BlogID, ref.LogNo)
+		if err != nil && !errors.Is(err, crawling.ErrBlogPostInaccessible) {
+			return nil, err
+		}
+		return post, err
+	case crawling.SourceCafe:
+		if s.cfg.Session == nil {
+			return nil, fmt.Errorf("서버에 네이버 로그인 세션이 설정되지 않았습니다")
+		}
+		return s.cfg.Crawler.CafeArticle(ref.CafeID, ref.ArticleID, s.cfg.Session)
+	}
+	return nil, fmt.Errorf("알 수 없는 출처: %s", ref.Source)
+}
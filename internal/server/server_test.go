@@ -0,0 +1,438 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"naverCrawler/internal/crawling"
+)
+
+// 페이지마다 게시글 2개를 OnPage로 넘기는 가짜 크롤러
+// release가 있으면 페이지마다 값을 받을 때까지 기다림
+// 블로그 게시글 1번은 비공개, 2번은 요청 실패
+type fakeCrawler struct {
+	pages   int
+	release chan struct{}
+}
+
+func (f *fakeCrawler) wait() {
+	if f.release != nil {
+		<-f.release
+	}
+}
+
+func (f *fakeCrawler) CrawlBlog(blogID string, opts crawling.BlogCrawlOptions) ([]crawling.BlogPost, error) {
+	if blogID == "broken" {
+		return nil, fmt.Errorf("블로그 목록 요청 실패")
+	}
+	var all []crawling.BlogPost
+	for page := 1; page <= f.pages; page++ {
+		f.wait()
+		posts := []crawling.BlogPost{
+			{ID: fmt.Sprintf("%d01", page), Title: fmt.Sprintf("%s %d-1", blogID, page)},
+			{ID: fmt.Sprintf("%d02", page), Title: fmt.Sprintf("%s %d-2", blogID, page)},
+		}
+		all = append(all, posts...)
+		opts.OnPage(page, f.pages, posts)
+	}
+	return all, nil
+}
+
+func (f *fakeCrawler) CrawlBoard(cafeId, boardID string, session *crawling.Session, opts crawling.CafeCrawlOptions) ([]map[string]interface{}, error) {
+	var all []map[string]interface{}
+	for page := 1; page <= f.pages; page++ {
+		f.wait()
+		posts := []map[string]interface{}{
+			{"id": fmt.Sprintf("%d01", page), "board_id": boardID},
+			{"id": fmt.Sprintf("%d02", page), "board_id": boardID},
+		}
+		all = append(all, posts...)
+		opts.OnPage(page, f.pages, posts)
+	}
+	return all, nil
+}
+
+func (f *fakeCrawler) BlogPost(blogID, logNo string) (crawling.BlogPost, error) {
+	switch logNo {
+	case "1":
+		return crawling.BlogPost{ID: logNo, Status: crawling.BlogPostPrivate},
+			fmt.Errorf("%w: %s", crawling.ErrBlogPostInaccessible, crawling.BlogPostPrivate)
+	case "2":
+		return crawling.BlogPost{}, fmt.Errorf("블로그 게시글 요청 실패")
+	}
+	return crawling.BlogPost{ID: logNo, Title: blogID + " " + logNo, Status: crawling.BlogPostOK}, nil
+}
+
+func (f *fakeCrawler) CafeArticle(cafeId string, articleId int, session *crawling.Session) (map[string]interface{}, error) {
+	return map[string]interface{}{"cafe_id": cafeId, "id": articleId}, nil
+}
+
+const testToken = "test-token"
+
+// 테스트 API 토큰을 붙여 보내는 클라이언트
+var client = &http.Client{Transport: tokenTransport{}}
+
+type tokenTransport struct{}
+
+func (tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newTestServer(t *testing.T, cfg Config) (*Server, *httptest.Server) {
+	t.Helper()
+	cfg.Token = testToken
+	srv := New(cfg)
+	ts := httptest.NewServer(srv.Handler())
+	t.Cleanup(func() {
+		ts.Close()
+		srv.Close()
+	})
+	return srv, ts
+}
+
+func postJob(t *testing.T, ts *httptest.Server, body string) (*http.Response, JobView) {
+	t.Helper()
+	resp, err := client.Post(ts.URL+"/jobs", "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var view JobView
+	json.NewDecoder(resp.Body).Decode(&view)
+	return resp, view
+}
+
+func getJSON(t *testing.T, url string, v interface{}) int {
+	t.Helper()
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatalf("%s 응답 JSON 오류: %v", url, err)
+	}
+	return resp.StatusCode
+}
+
+func waitStatus(t *testing.T, ts *httptest.Server, id string, want JobStatus) JobView {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		var view JobView
+		getJSON(t, ts.URL+"/jobs/"+id, &view)
+		if view.Status == want {
+			return view
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("작업 %s 상태 = %s, want %s", id, view.Status, want)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestBlogJob(t *testing.T) {
+	_, ts := newTestServer(t, Config{Crawler: &fakeCrawler{pages: 3}})
+
+	resp, view := postJob(t, ts, `{"type":"blog","blog_id":"allminwon","max_pages":3}`)
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("POST /jobs status = %d, want 202", resp.StatusCode)
+	}
+	if got := resp.Header.Get("Location"); got != "/jobs/"+view.ID {
+		t.Errorf("Location = %q, want /jobs/%s", got, view.ID)
+	}
+
+	done := waitStatus(t, ts, view.ID, StatusDone)
+	if want := (Progress{Done: 3, Total: 3, Posts: 6}); done.Progress != want {
+		t.Errorf("progress = %+v, want %+v", done.Progress, want)
+	}
+	if done.StartedAt == nil || done.FinishedAt == nil {
+		t.Errorf("started_at/finished_at이 비어있음: %+v", done)
+	}
+
+	var views []JobView
+	getJSON(t, ts.URL+"/jobs", &views)
+	if len(views) != 1 || views[0].ID != view.ID {
+		t.Errorf("GET /jobs = %+v", views)
+	}
+}
+
+func TestFailedJob(t *testing.T) {
+	_, ts := newTestServer(t, Config{Crawler: &fakeCrawler{pages: 1}})
+
+	_, view := postJob(t, ts, `{"type":"blog","blog_id":"broken"}`)
+	failed := waitStatus(t, ts, view.ID, StatusFailed)
+	if failed.Error == "" {
+		t.Error("실패한 작업에 error가 없음")
+	}
+}
+
+func TestResultsStream(t *testing.T) {
+	crawler := &fakeCrawler{pages: 2, release: make(chan struct{})}
+	session := crawling.NewSession("NID_AUT=a; NID_SES=b")
+	_, ts := newTestServer(t, Config{Crawler: crawler, Session: session})
+
+	_, view := postJob(t, ts, `{"type":"cafe","cafe_id":"12345","board_id":"7"}`)
+
+	resp, err := client.Get(ts.URL + "/jobs/" + view.ID + "/results")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if got := resp.Header.Get("Content-Type"); !strings.HasPrefix(got, "application/x-ndjson") {
+		t.Errorf("Content-Type = %q", got)
+	}
+
+	// 페이지가 끝날 때마다 결과가 흘러나와야 함
+	lines := bufio.NewScanner(resp.Body)
+	var ids []string
+	for page := 1; page <= crawler.pages; page++ {
+		crawler.release <- struct{}{}
+		for i := 0; i < 2; i++ {
+			if !lines.Scan() {
+				t.Fatalf("%d페이지 결과를 받기 전에 스트림이 끝남: %v", page, lines.Err())
+			}
+			var post map[string]interface{}
+			if err := json.Unmarshal(lines.Bytes(), &post); err != nil {
+				t.Fatalf("결과 JSON 오류: %v (%s)", err, lines.Text())
+			}
+			ids = append(ids, post["id"].(string))
+		}
+	}
+	if lines.Scan() {
+		t.Errorf("예상하지 못한 결과: %s", lines.Text())
+	}
+	if got, want := strings.Join(ids, ","), "101,102,201,202"; got != want {
+		t.Errorf("결과 = %s, want %s", got, want)
+	}
+
+	// 끝난 작업은 from 이후 결과만 주고 바로 닫힘
+	resp, err = client.Get(ts.URL + "/jobs/" + view.ID + "/results?from=3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var rest []string
+	lines = bufio.NewScanner(resp.Body)
+	for lines.Scan() {
+		rest = append(rest, lines.Text())
+	}
+	if len(rest) != 1 || !strings.Contains(rest[0], `"202"`) {
+		t.Errorf("from=3 결과 = %v", rest)
+	}
+}
+
+func TestURLJob(t *testing.T) {
+	_, ts := newTestServer(t, Config{Crawler: &fakeCrawler{}})
+
+	_, view := postJob(t, ts, `{"type":"urls","urls":[
+		"https://blog.naver.com/allminwon/223428124420",
+		"https://m.blog.naver.com/allminwon/1",
+		"https://blog.naver.com/PostView.naver?blogId=allminwon&logNo=2"
+	]}`)
+	done := waitStatus(t, ts, view.ID, StatusDone)
+	if want := (Progress{Done: 3, Total: 3, Posts: 3}); done.Progress != want {
+		t.Errorf("progress = %+v, want %+v", done.Progress, want)
+	}
+
+	resp, err := client.Get(ts.URL + "/jobs/" + view.ID + "/results")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var results []urlResult
+	dec := json.NewDecoder(resp.Body)
+	for dec.More() {
+		var r urlResult
+		if err := dec.Decode(&r); err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, r)
+	}
+	if len(results) != 3 {
+		t.Fatalf("결과 %d개, want 3", len(results))
+	}
+	if results[0].Error != "" || results[0].Post == nil {
+		t.Errorf("공개 글 결과 = %+v", results[0])
+	}
+	if results[1].Error == "" || results[1].Post == nil {
+		t.Errorf("비공개 글은 상태가 담긴 게시글과 오류를 함께 반환해야 함: %+v", results[1])
+	}
+	if results[2].Error == "" || results[2].Post != nil {
+		t.Errorf("요청 실패 결과 = %+v", results[2])
+	}
+}
+
+func TestSubmitValidation(t *testing.T) {
+	_, ts := newTestServer(t, Config{Crawler: &fakeCrawler{}})
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"invalid json", `{"type":`},
+		{"unknown field", `{"type":"blog","blog_id":"a","blogid":"a"}`},
+		{"unknown type", `{"type":"rss"}`},
+		{"blog without id", `{"type":"blog"}`},
+		{"unknown backend", `{"type":"blog","blog_id":"a","backend":"graphql"}`},
+		{"cafe without session", `{"type":"cafe","cafe_id":"1","board_id":"2"}`},
+		{"empty urls", `{"type":"urls","urls":[]}`},
+		{"unsupported url", `{"type":"urls","urls":["https://example.com/a"]}`},
+		{"cafe url without session", `{"type":"urls","urls":["https://cafe.naver.com/ca-fe/cafes/1/articles/2"]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.Post(ts.URL+"/jobs", "application/json", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			var body map[string]string
+			json.NewDecoder(resp.Body).Decode(&body)
+			if resp.StatusCode != http.StatusBadRequest || body["error"] == "" {
+				t.Errorf("status = %d, body = %v, want 400 with error", resp.StatusCode, body)
+			}
+		})
+	}
+}
+
+func TestQueueFullAndCancel(t *testing.T) {
+	crawler := &fakeCrawler{pages: 1, release: make(chan struct{})}
+	_, ts := newTestServer(t, Config{Crawler: crawler, Workers: 1, QueueSize: 1})
+
+	_, running := postJob(t, ts, `{"type":"blog","blog_id":"a"}`)
+	waitStatus(t, ts, running.ID, StatusRunning)
+	_, queued := postJob(t, ts, `{"type":"blog","blog_id":"b"}`)
+
+	resp, _ := postJob(t, ts, `{"type":"blog","blog_id":"c"}`)
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("대기열이 찼을 때 status = %d, want 503", resp.StatusCode)
+	}
+
+	cancel := func(id string) int {
+		req, _ := http.NewRequest(http.MethodDelete, ts.URL+"/jobs/"+id, nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	if got := cancel(running.ID); got != http.StatusConflict {
+		t.Errorf("실행 중인 작업 취소 status = %d, want 409", got)
+	}
+	if got := cancel(queued.ID); got != http.StatusOK {
+		t.Errorf("대기 중인 작업 취소 status = %d, want 200", got)
+	}
+	if got := cancel("job-999999"); got != http.StatusNotFound {
+		t.Errorf("없는 작업 취소 status = %d, want 404", got)
+	}
+
+	crawler.release <- struct{}{}
+	waitStatus(t, ts, running.ID, StatusDone)
+	if view := waitStatus(t, ts, queued.ID, StatusCanceled); view.StartedAt != nil {
+		t.Errorf("취소된 작업이 실행됨: %+v", view)
+	}
+}
+
+func TestPruneFinishedJobs(t *testing.T) {
+	srv, ts := newTestServer(t, Config{Crawler: &fakeCrawler{}, Workers: 1, MaxFinishedJobs: 2})
+
+	var last JobView
+	for i := 0; i < 4; i++ {
+		_, last = postJob(t, ts, `{"type":"blog","blog_id":"a"}`)
+		waitStatus(t, ts, last.ID, StatusDone)
+	}
+	jobs := srv.Jobs()
+	if len(jobs) != 2 || jobs[1].ID != last.ID {
+		t.Errorf("남은 작업 %d개, want 최근 2개", len(jobs))
+	}
+}
+
+func TestDirectFetch(t *testing.T) {
+	session := crawling.NewSession("NID_AUT=a; NID_SES=b")
+	_, withSession := newTestServer(t, Config{Crawler: &fakeCrawler{}, Session: session})
+	_, noSession := newTestServer(t, Config{Crawler: &fakeCrawler{}})
+
+	tests := []struct {
+		name       string
+		url        string
+		wantStatus int
+		wantBody   string
+	}{
+		{"blog post", withSession.URL + "/blogs/allminwon/posts/223428124420", 200, `"title":"allminwon 223428124420"`},
+		{"private blog post", withSession.URL + "/blogs/allminwon/posts/1", 200, `"status":"private"`},
+		{"blog request error", withSession.URL + "/blogs/allminwon/posts/2", 502, `"error"`},
+		{"cafe article", withSession.URL + "/cafes/12345/articles/678", 200, `"id":678`},
+		{"cafe bad id", withSession.URL + "/cafes/12345/articles/abc", 400, `"error"`},
+		{"cafe without session", noSession.URL + "/cafes/12345/articles/678", 503, `"error"`},
+		{"blog url", noSession.URL + "/posts?url=https://m.blog.naver.com/allminwon/223428124420", 200, `"id":"223428124420"`},
+		{"cafe url", withSession.URL + "/posts?url=https://cafe.naver.com/ca-fe/cafes/12345/articles/678", 200, `"cafe_id":"12345"`},
+		{"unsupported url", withSession.URL + "/posts?url=https://example.com", 400, `"error"`},
+		{"healthz", noSession.URL + "/healthz", 200, `"status":"ok"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.Get(tt.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			var b strings.Builder
+			bufio.NewReader(resp.Body).WriteTo(&b)
+			if resp.StatusCode != tt.wantStatus || !strings.Contains(b.String(), tt.wantBody) {
+				t.Errorf("status = %d, body = %s, want %d containing %s", resp.StatusCode, b.String(), tt.wantStatus, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestAuth(t *testing.T) {
+	_, ts := newTestServer(t, Config{Crawler: &fakeCrawler{}})
+	// 토큰을 설정하지 않은 서버는 모든 요청을 거절
+	srv := New(Config{Crawler: &fakeCrawler{}})
+	noToken := httptest.NewServer(srv.Handler())
+	t.Cleanup(func() {
+		noToken.Close()
+		srv.Close()
+	})
+
+	tests := []struct {
+		name       string
+		url        string
+		auth       string
+		wantStatus int
+	}{
+		{"no header", ts.URL + "/jobs", "", 401},
+		{"wrong token", ts.URL + "/jobs", "Bearer wrong", 401},
+		{"not bearer", ts.URL + "/jobs", testToken, 401},
+		{"valid token", ts.URL + "/jobs", "Bearer " + testToken, 200},
+		{"direct fetch without token", ts.URL + "/blogs/allminwon/posts/223428124420", "", 401},
+		{"healthz without token", ts.URL + "/healthz", "", 200},
+		{"server without token", noToken.URL + "/jobs", "Bearer ", 401},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.auth != "" {
+				req.Header.Set("Authorization", tt.auth)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
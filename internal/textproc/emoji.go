@@ -0,0 +1,123 @@
+package textproc
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// 그림 문자 이모지 범위
+func isEmojiRune(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // 이모티콘, 그림 기호, 국기, 보충 기호
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // 기타 기호, 딩뱃 (☀️ ✨ ❤️)
+		return true
+	case r >= 0x2B00 && r <= 0x2BFF: // ⭐ ⬆️
+		return true
+	case r == 0x231A || r == 0x231B || (r >= 0x23E9 && r <= 0x23FF): // ⌚ ⏰
+		return true
+	case r == 0x3030 || r == 0x303D || r == 0x3297 || r == 0x3299:
+		return true
+	}
+	return false
+}
+
+// 이모지와 이어 붙는 글자 (ZWJ, 이형 선택자, 피부색, 키캡)
+func isEmojiJoiner(r rune) bool {
+	return r == zeroWidthJoiner || r == '\ufe0e' || r == '\ufe0f' || r == '\u20e3' || (r >= 0x1F3FB && r <= 0x1F3FF)
+}
+
+// 글자로 만든 이모티콘
+// ^^, ^_^, ㅠㅠ, ㅋㅋㅋ, ㅎㅎ, T_T, >_<, (._.), (ㅠ_ㅠ), ¯\_(ツ)_/¯
+var kaomojiPattern = regexp.MustCompile(`¯\\_\(ツ\)_/¯` +
+	`|[(（][^()（）가-힣A-Za-z0-9\s]{1,3}[_.ㅅω▽∀□ー\-][^()（）가-힣A-Za-z0-9\s]{1,3}[)）]` +
+	`|\^[_\-ㅇo.]?\^+` +
+	`|[ㅠㅜ]{2,}|[ㅋㅎ]{2,}` +
+	`|T[_.]T|>[_.]<|;[_.];`)
+
+// :) :D ;) 는 시간(12:30)이나 목록 기호와 헷갈리지 않도록 앞이 공백이거나 문장 처음일 때만
+var emoticonPattern = regexp.MustCompile(`(?:^|\s)([:;]-?[)(DPp])`)
+
+// 이모지/이모티콘 위치 (바이트 단위, 앞에서부터 겹치지 않게)
+func emojiSpans(s string) [][2]int {
+	var spans [][2]int
+
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !isEmojiRune(r) {
+			i += size
+			continue
+		}
+		// 이모지 + 이어 붙는 글자들 (ZWJ 뒤의 이모지까지)
+		start := i
+		i += size
+		for i < len(s) {
+			next, nextSize := utf8.DecodeRuneInString(s[i:])
+			if isEmojiJoiner(next) || (isEmojiRune(next) && isRegionalIndicator(r) && isRegionalIndicator(next)) {
+				i += nextSize
+				continue
+			}
+			prev, _ := utf8.DecodeLastRuneInString(s[:i])
+			if prev == zeroWidthJoiner && isEmojiRune(next) {
+				i += nextSize
+				continue
+			}
+			break
+		}
+		spans = append(spans, [2]int{start, i})
+	}
+
+	for _, m := range kaomojiPattern.FindAllStringIndex(s, -1) {
+		spans = append(spans, [2]int{m[0], m[1]})
+	}
+	for _, m := range emoticonPattern.FindAllStringSubmatchIndex(s, -1) {
+		spans = append(spans, [2]int{m[2], m[3]})
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i][0] < spans[j][0] })
+	var merged [][2]int
+	for _, sp := range spans {
+		if n := len(merged); n > 0 && sp[0] < merged[n-1][1] {
+			if sp[1] > merged[n-1][1] {
+				merged[n-1][1] = sp[1]
+			}
+			continue
+		}
+		merged = append(merged, sp)
+	}
+	return merged
+}
+
+// 국기 이모지는 지역 표시 문자 두 개로 이루어짐
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// ExtractEmoji 본문에 나온 이모지와 이모티콘 (나온 순서대로, 중복 포함)
+func ExtractEmoji(s string) []string {
+	var found []string
+	for _, sp := range emojiSpans(s) {
+		found = append(found, s[sp[0]:sp[1]])
+	}
+	return found
+}
+
+// StripEmoji 이모지와 이모티콘을 지우고 공백 정리
+func StripEmoji(s string) string {
+	spans := emojiSpans(s)
+	if len(spans) == 0 {
+		return s
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, sp := range spans {
+		b.WriteString(s[last:sp[0]])
+		b.WriteByte(' ')
+		last = sp[1]
+	}
+	b.WriteString(s[last:])
+	return strings.Join(strings.Fields(b.String()), " ")
+}
@@ -0,0 +1,87 @@
+// Package textproc 수집한 한국어 본문을 분석에 바로 쓸 수 있도록 정리하는 단계
+// (유니코드 NFC 정규화, 보이지 않는 문자 제거, 이모지/이모티콘 처리, 글자 단위 자르기, 문장 나누기, 토큰 나누기)
+package textproc
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// 폭 없는 공백 (ZWJ는 이모지 조합에 쓰이므로 따로 처리)
+const zeroWidthJoiner = '\u200d'
+
+func isZeroWidth(r rune) bool {
+	switch r {
+	case '\u200b', '\u200c', '\u2060', '\ufeff', '\u00ad', '\u180e':
+		return true
+	}
+	return false
+}
+
+// Normalize NFC 정규화 후 보이지 않는 문자를 지우고 연속된 공백을 하나로
+// (맥에서 붙여넣은 자모 분리 한글, 네이버 에디터가 넣는 폭 없는 공백, 줄바꿈 없는 공백(&nbsp;) 정리)
+func Normalize(s string) string {
+	if s == "" {
+		return ""
+	}
+	s = norm.NFC.String(s)
+
+	runes := []rune(s)
+	var b strings.Builder
+	b.Grow(len(s))
+	for i, r := range runes {
+		switch {
+		case isZeroWidth(r):
+			continue
+		case r == zeroWidthJoiner:
+			// 이모지 사이(👨‍👩‍👧)의 ZWJ만 남김
+			if i == 0 || i == len(runes)-1 || !(isEmojiRune(runes[i-1]) || isEmojiJoiner(runes[i-1])) || !isEmojiRune(runes[i+1]) {
+				continue
+			}
+		case unicode.IsSpace(r):
+			r = ' '
+		case unicode.IsControl(r):
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// Truncate 글자(rune) 단위로 maxRunes까지 자르고 "..."을 붙임 (한글이나 이모지 중간에서 끊지 않음)
+func Truncate(s string, maxRunes int) string {
+	if maxRunes < 0 {
+		maxRunes = 0
+	}
+	runes := []rune(s)
+	if len(runes) <= maxRunes {
+		return s
+	}
+
+	// 이모지 조합(ZWJ, 이형 선택자, 피부색)이나 조합형 한글 자모 중간이면 그 글자 앞까지 자름
+	cut := maxRunes
+	for cut > 0 && continuesCluster(runes, cut) {
+		cut--
+	}
+	return string(runes[:cut]) + "..."
+}
+
+// runes[i]가 앞 글자와 이어지는 글자인지
+func continuesCluster(runes []rune, i int) bool {
+	r := runes[i]
+	switch {
+	case r == zeroWidthJoiner, runes[i-1] == zeroWidthJoiner:
+		return true
+	case r == '\ufe0e' || r == '\ufe0f': // 이형 선택자
+		return true
+	case r >= 0x1F3FB && r <= 0x1F3FF: // 피부색
+		return true
+	case r >= 0x1160 && r <= 0x11FF: // 조합형 한글 중성/종성
+		return true
+	case r == '\u20e3': // 키캡
+		return true
+	}
+	return false
+}
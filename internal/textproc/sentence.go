@@ -0,0 +1,88 @@
+package textproc
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 문장 끝 문장부호
+const sentenceEnders = ".!?…。？！"
+
+// 문장부호 없이 끝나는 블로그 글이 많아 자주 쓰는 종결 어미로도 문장 끝을 판단
+var sentenceEndings = []string{
+	"습니다", "니다", "어요", "아요", "에요", "예요", "해요", "네요", "세요", "군요", "죠",
+	"했다", "였다", "었다", "겠다", "는다", "한다", "이다", "있다", "없다", "같다", "좋다", "싶다",
+}
+
+// 문장 끝 뒤에 붙는 닫는 따옴표/괄호, 물결, 자모(ㅋㅋ, ㅠㅠ)
+func isTrailing(r rune) bool {
+	if strings.ContainsRune(`"'”’」』)]}~`, r) {
+		return true
+	}
+	return r >= 'ㄱ' && r <= 'ㅣ'
+}
+
+// 이모지/이모티콘과 뒤에 붙는 글자를 뺀 단어
+func wordCore(word string) string {
+	core := StripEmoji(word)
+	for core != "" {
+		r, size := utf8.DecodeLastRuneInString(core)
+		if !isTrailing(r) && !isEmojiJoiner(r) {
+			break
+		}
+		core = core[:len(core)-size]
+	}
+	return core
+}
+
+// 단어가 문장의 끝인지
+func endsSentence(word string) bool {
+	core := wordCore(word)
+	if core == "" {
+		return false
+	}
+
+	last, _ := utf8.DecodeLastRuneInString(core)
+	if strings.ContainsRune(sentenceEnders, last) {
+		// "1." 같은 목록 번호는 문장 끝이 아님
+		return strings.TrimFunc(core, func(r rune) bool {
+			return unicode.IsDigit(r) || strings.ContainsRune(sentenceEnders, r)
+		}) != ""
+	}
+	for _, ending := range sentenceEndings {
+		if strings.HasSuffix(core, ending) {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitSentences 한국어 본문을 문장 단위로 나눔
+// 문장부호(. ! ? …)와 종결 어미(~습니다, ~어요, ~했다 등)로 끝나는 단어에서 끊고,
+// 문장 뒤에 따로 떨어진 이모지/이모티콘은 앞 문장에 붙임
+func SplitSentences(text string) []string {
+	var sentences []string
+	var current []string
+
+	flush := func() {
+		if len(current) > 0 {
+			sentences = append(sentences, strings.Join(current, " "))
+			current = nil
+		}
+	}
+
+	for _, word := range strings.Fields(Normalize(text)) {
+		if wordCore(word) == "" && len(current) == 0 && len(sentences) > 0 {
+			// 이모지/이모티콘만 있는 단어는 앞 문장에
+			sentences[len(sentences)-1] += " " + word
+			continue
+		}
+		current = append(current, word)
+		if endsSentence(word) {
+			flush()
+		}
+	}
+	flush()
+	return sentences
+}
@@ -0,0 +1,49 @@
+package textproc
+
+// Options 본문 처리 방법
+type Options struct {
+	StripEmoji bool      // 본문에서 이모지/이모티콘 지우기 (지운 이모지는 Features.Emoji에 남음)
+	Sentences  bool      // 문장 나누기
+	Tokenizer  Tokenizer // 토큰 나누기 (nil이면 토큰 없음)
+}
+
+// FeatureOptions 플래그/설정 값으로 본문 처리 방법 만들기 (둘 다 false면 nil: 처리 안 함)
+// features면 문장과 SimpleTokenizer 토큰을 채우고, stripEmoji면 본문에서 이모지를 지움
+func FeatureOptions(features, stripEmoji bool) *Options {
+	if !features && !stripEmoji {
+		return nil
+	}
+	opts := &Options{StripEmoji: stripEmoji}
+	if features {
+		opts.Sentences = true
+		opts.Tokenizer = SimpleTokenizer{}
+	}
+	return opts
+}
+
+// Features 분석용으로 처리한 본문
+type Features struct {
+	Text      string   `json:"text"` // 정규화한 본문 (StripEmoji면 이모지 제외)
+	Sentences []string `json:"sentences,omitempty"`
+	Tokens    []string `json:"tokens,omitempty"`
+	Emoji     []string `json:"emoji,omitempty"`
+}
+
+// Process 본문을 정규화하고 옵션에 따라 이모지/문장/토큰 추출
+func Process(text string, opts Options) Features {
+	text = Normalize(text)
+
+	f := Features{Emoji: ExtractEmoji(text)}
+	if opts.StripEmoji {
+		text = StripEmoji(text)
+	}
+	f.Text = text
+
+	if opts.Sentences {
+		f.Sentences = SplitSentences(text)
+	}
+	if opts.Tokenizer != nil {
+		f.Tokens = opts.Tokenizer.Tokenize(text)
+	}
+	return f
+}
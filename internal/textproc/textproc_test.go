@@ -0,0 +1,130 @@
+package textproc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"nfd hangul", "\u1112\u1161\u11ab\u1100\u1173\u11af", "한글"},
+		{"zero width", "제주\u200b도에\ufeff 다녀\u00ad왔어요", "제주도에 다녀왔어요"},
+		{"spaces", " 날씨가\u00a0정말\n\t좋았어요!  ", "날씨가 정말 좋았어요!"},
+		{"emoji zwj kept", "가족 👨\u200d👩\u200d👧 여행", "가족 👨\u200d👩\u200d👧 여행"},
+		{"stray zwj removed", "제\u200d주", "제주"},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.in); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		in   string
+		max  int
+		want string
+	}{
+		{"제주도에 다녀왔습니다", 3, "제주도..."},
+		{"짧은 글", 10, "짧은 글"},
+		{"ab👨\u200d👩\u200d👧cd", 3, "ab..."},
+		{"ab👍🏻cd", 3, "ab..."},
+		{"abc", 0, "..."},
+	}
+	for _, tt := range tests {
+		if got := Truncate(tt.in, tt.max); got != tt.want {
+			t.Errorf("Truncate(%q, %d) = %q, want %q", tt.in, tt.max, got, tt.want)
+		}
+	}
+}
+
+func TestEmoji(t *testing.T) {
+	text := "오늘 날씨 최고☀\ufe0f 바다 보러 갔어요^^ 사진은 내일 올릴게요ㅋㅋㅋ 다리가 아파요 ㅠㅠ :) 🇰🇷 (._.) 12:30)"
+	want := []string{"☀\ufe0f", "^^", "ㅋㅋㅋ", "ㅠㅠ", ":)", "🇰🇷", "(._.)"}
+	if got := ExtractEmoji(text); !reflect.DeepEqual(got, want) {
+		t.Errorf("ExtractEmoji = %q, want %q", got, want)
+	}
+
+	wantText := "오늘 날씨 최고 바다 보러 갔어요 사진은 내일 올릴게요 다리가 아파요 12:30)"
+	if got := StripEmoji(text); got != wantText {
+		t.Errorf("StripEmoji = %q, want %q", got, wantText)
+	}
+}
+
+func TestSplitSentences(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{
+			"punctuation",
+			"제주도에 다녀왔습니다. 날씨가 정말 좋았어요! 다음에 또 갈까요?",
+			[]string{"제주도에 다녀왔습니다.", "날씨가 정말 좋았어요!", "다음에 또 갈까요?"},
+		},
+		{
+			"endings without punctuation",
+			"오늘은 성수동 카페에 갔어요 커피가 맛있었다 또 가고 싶다",
+			[]string{"오늘은 성수동 카페에 갔어요", "커피가 맛있었다", "또 가고 싶다"},
+		},
+		{
+			"trailing emoticons",
+			"바다가 예뻤어요ㅎㅎ 다리가 아팠지만 괜찮아요 😀 내일 또 올게요",
+			[]string{"바다가 예뻤어요ㅎㅎ", "다리가 아팠지만 괜찮아요 😀", "내일 또 올게요"},
+		},
+		{
+			"list numbers and decimals",
+			"1. 준비물 확인 2. 평점은 4.5점입니다.",
+			[]string{"1. 준비물 확인 2. 평점은 4.5점입니다."},
+		},
+		{"empty", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitSentences(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitSentences = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimpleTokenizer(t *testing.T) {
+	got := SimpleTokenizer{}.Tokenize("제주도에서 고양이를 만났어요^^ 사진은 Instagram에도 올렸어요ㅋㅋ")
+	want := []string{"제주도", "고양이", "만났어요", "사진", "instagram", "올렸어요"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Tokenize = %q, want %q", got, want)
+	}
+}
+
+func TestProcess(t *testing.T) {
+	f := Process("날씨가 좋았어요😀 또 가고 싶다", Options{StripEmoji: true, Sentences: true, Tokenizer: SimpleTokenizer{}})
+	want := Features{
+		Text:      "날씨가 좋았어요 또 가고 싶다",
+		Sentences: []string{"날씨가 좋았어요", "또 가고 싶다"},
+		Tokens:    []string{"날씨가", "좋았어요", "또", "가고", "싶다"},
+		Emoji:     []string{"😀"},
+	}
+	if !reflect.DeepEqual(f, want) {
+		t.Errorf("Process = %+v, want %+v", f, want)
+	}
+}
+
+func TestFeatureOptions(t *testing.T) {
+	if FeatureOptions(false, false) != nil {
+		t.Error("FeatureOptions(false, false) must be nil")
+	}
+	if opts := FeatureOptions(false, true); opts == nil || !opts.StripEmoji || opts.Sentences || opts.Tokenizer != nil {
+		t.Errorf("strip only = %+v", opts)
+	}
+	if opts := FeatureOptions(true, false); opts == nil || opts.StripEmoji || !opts.Sentences || opts.Tokenizer == nil {
+		t.Errorf("features only = %+v", opts)
+	}
+}
@@ -0,0 +1,68 @@
+package textproc
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Tokenizer 본문을 토큰으로 나누는 방법
+// 형태소 분석기(mecab-ko, khaiii 등)를 쓰려면 이 인터페이스를 구현해 Options.Tokenizer로 넘김
+type Tokenizer interface {
+	Tokenize(text string) []string
+}
+
+// 단어 끝에서 떼어 낼 조사 (긴 것부터 확인)
+var particles = []string{
+	"에서부터", "으로부터", "에게서", "한테서", "이라고", "에서는", "에서도", "으로는", "으로도", "까지는", "까지도", "부터는", "에게는", "에게도",
+	"에는", "에도", "와는", "과는", "와도", "과도", "만은",
+	"에서", "에게", "한테", "께서", "까지", "부터", "처럼", "보다", "으로", "이랑", "하고", "마저", "조차", "이나", "이다",
+	// 이/가/로/나는 명사 끝 글자인 경우(고양이, 휴가, 도로)가 많아 빼 둠
+	"은", "는", "을", "를", "에", "의", "도", "만", "와", "과", "랑",
+}
+
+// SimpleTokenizer 형태소 분석기 없이 공백/문장부호로 나누고 조사만 떼어 내는 가벼운 토크나이저
+// (영문은 소문자로, 이모지/이모티콘과 한 글자 기호는 버림)
+type SimpleTokenizer struct{}
+
+// Tokenize 본문을 토큰으로 나눔
+func (SimpleTokenizer) Tokenize(text string) []string {
+	words := strings.FieldsFunc(StripEmoji(Normalize(text)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var tokens []string
+	for _, word := range words {
+		word = strings.ToLower(word)
+		if isJamoOnly(word) {
+			continue
+		}
+		tokens = append(tokens, stripParticle(word))
+	}
+	return tokens
+}
+
+// 한 글자 조사는 두 글자 이상 남을 때만 떼어 냄 ("가는", "나는"처럼 조사가 아닌 경우가 많으므로)
+func stripParticle(word string) string {
+	n := utf8.RuneCountInString(word)
+	for _, p := range particles {
+		if !strings.HasSuffix(word, p) {
+			continue
+		}
+		rest := n - utf8.RuneCountInString(p)
+		if rest >= 2 || (rest >= 1 && utf8.RuneCountInString(p) > 1) {
+			return strings.TrimSuffix(word, p)
+		}
+	}
+	return word
+}
+
+// ㅋㅋ, ㅠㅠ 처럼 자모만 있는 단어
+func isJamoOnly(word string) bool {
+	for _, r := range word {
+		if r < 'ㄱ' || r > 'ㅣ' {
+			return false
+		}
+	}
+	return true
+}
@@ -1,37 +1,19 @@
 package utils
 
 import (
-	"strings"
+	"naverCrawler/internal/textproc"
 
 	"github.com/PuerkitoBio/goquery"
 )
 
-// 문자열 정리 함수 개선
+// 문자열 정리 함수 개선 (NFC 정규화, 보이지 않는 문자 제거, 연속된 공백을 하나로)
 func CleanText(text string) string {
-	if text == "" {
-		return ""
-	}
-
-	// 탭, 개행 등을 공백으로 변환
-	text = strings.ReplaceAll(text, "\t", " ")
-	text = strings.ReplaceAll(text, "\n", " ")
-	text = strings.ReplaceAll(text, "\r", " ")
-
-	// 연속된 공백을 하나로
-	text = strings.Join(strings.Fields(text), " ")
-
-	// 앞뒤 공백 제거
-	text = strings.TrimSpace(text)
-
-	return text
+	return textproc.Normalize(text)
 }
 
-// 문자열을 특정 길이로 자르고 "..."을 추가하는 헬퍼 함수
+// 문자열을 특정 글자 수로 자르고 "..."을 추가하는 헬퍼 함수 (한글/이모지 중간에서 끊지 않음)
 func TruncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
-	}
-	return s[:maxLen] + "..."
+	return textproc.Truncate(s, maxLen)
 }
 
 // 여러 셀렉터 중 첫 번째 매칭을 찾는 함수